@@ -6,7 +6,9 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
 
 	"github.com/chain4travel/camino-signavault/dao"
 	"github.com/chain4travel/camino-signavault/db"
@@ -32,6 +34,9 @@ import (
 // @schemes http
 func main() {
 	config := util.GetInstance()
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		os.Exit(verifyLedger(os.Args[2:]))
+	}
 	startRouter(config)
 }
 
@@ -39,6 +44,7 @@ func startRouter(cfg *util.Config) {
 	// gin.SetMode(gin.DebugMode)
 	router := gin.Default()
 	router.Use(cors.Default())
+	router.Use(handler.RequestId())
 	err := router.SetTrustedProxies(nil)
 	if err != nil {
 		return
@@ -46,15 +52,44 @@ func startRouter(cfg *util.Config) {
 	api := router.Group("/v1")
 
 	nodeService := service.NewNodeService(cfg)
+	multisigTxDao := dao.NewMultisigTxDao(db.GetInstance())
+	webhookDao := dao.NewWebhookDao(db.GetInstance())
+	// the events reach the live event streams directly and all other sinks through the outbox
+	eventBus := service.NewEventBus()
+	outboxSinks, err := service.NewOutboxSinks(cfg, webhookDao)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	service.NewTxStatusTracker(cfg, multisigTxDao, nodeService, eventBus).Start(context.Background())
+	service.NewStaleInputChecker(cfg, multisigTxDao, nodeService).Start(context.Background())
+	service.NewAliasDriftChecker(cfg, multisigTxDao, nodeService).Start(context.Background())
+	service.NewExpirySweeper(cfg, multisigTxDao, eventBus).Start(context.Background())
+	service.NewOutboxRelay(cfg, multisigTxDao, outboxSinks).Start(context.Background())
+	service.NewWebhookDispatcher(cfg, webhookDao).Start(context.Background())
 
-	multisigService := service.NewMultisigService(cfg, dao.NewMultisigTxDao(db.GetInstance()), nodeService)
+	multisigService := service.NewMultisigService(cfg, multisigTxDao, nodeService, eventBus, eventBus)
 	h := handler.NewMultisigHandler(multisigService)
 
 	api.POST("/multisig", h.CreateMultisigTx)
 	api.POST("/multisig/issue", h.IssueMultisigTx)
 	api.POST("/multisig/cancel", h.CancelMultisigTx)
+	api.POST("/multisig/sign-batch", h.SignMultisigTxBatch)
 	api.PUT("/multisig/:id", h.SignMultisigTx)
 	api.GET("/multisig/:alias", h.GetAllMultisigTxForAlias)
+	api.GET("/multisig/:alias/history", h.GetMultisigTxHistory)
+	api.GET("/multisig/:alias/audit", h.GetAuditEvents)
+	api.GET("/multisig/:alias/events", h.StreamEvents)
+	api.GET("/multisig/:alias/events/ws", h.StreamEventsWebSocket)
+	api.GET("/multisig/:alias/signed-tx", h.GetSignedMultisigTx)
+	api.GET("/multisig/tx/:id", h.GetMultisigTx)
+	api.GET("/multisig/tx/:id/decoded", h.GetDecodedMultisigTx)
+	api.GET("/multisig/tx/:id/children", h.GetChildMultisigTxs)
+	api.GET("/multisig/tx/:id/proof", h.GetSignatureProof)
+	api.DELETE("/multisig/tx/:id/signature", h.WithdrawSignature)
+	api.POST("/multisig/tx/:id/reject", h.RejectMultisigTx)
+	api.POST("/multisig/tx/:id/extend", h.ExtendMultisigTx)
+	api.GET("/owners/:address/pending", h.GetPendingMultisigTxForOwner)
 
 	depositOfferService := service.NewDepositOfferService(cfg, dao.NewDepositOfferDao(db.GetInstance()), nodeService)
 	doh := handler.NewDepositOfferHandler(depositOfferService)
@@ -62,6 +97,14 @@ func startRouter(cfg *util.Config) {
 	api.POST("/deposit-offer", doh.AddSignature)
 	api.GET("/deposit-offer/:address", doh.GetSignatures)
 
+	wh := handler.NewWebhookHandler(service.NewWebhookService(webhookDao))
+	webhooks := api.Group("/webhooks", handler.ApiKey(cfg.WebhookApiKey))
+	webhooks.POST("", wh.CreateSubscription)
+	webhooks.GET("", wh.GetSubscriptions)
+	webhooks.DELETE("/:id", wh.DeleteSubscription)
+	webhooks.GET("/dead-letters", wh.GetDeadLetters)
+	webhooks.POST("/dead-letters/:id/redeliver", wh.RedeliverDeadLetter)
+
 	err = router.Run(cfg.ListenerAddress)
 	if err != nil {
 		log.Fatal(err)
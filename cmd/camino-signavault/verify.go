@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/db"
+	"github.com/chain4travel/camino-signavault/service"
+)
+
+// verifyLedger checks the signature ledger against the stored signatures and returns the exit code,
+// which is 1 if the ledger or the signatures have been tampered with
+func verifyLedger(args []string) int {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	seed := flags.Bool("seed", false, "chain the stored signatures which are not in the ledger yet before verifying, e.g. the ones stored before the ledger was introduced")
+	_ = flags.Parse(args)
+
+	verifier := service.NewLedgerVerifier(dao.NewMultisigTxDao(db.GetInstance()))
+	if *seed {
+		seeded, err := verifier.Seed()
+		if err != nil {
+			log.Printf("Seeding the signature ledger failed: %v", err)
+			return 1
+		}
+		log.Printf("Chained %d stored signatures into the signature ledger", seeded)
+	}
+
+	report, err := verifier.Verify()
+	if err != nil {
+		log.Printf("Verifying the signature ledger failed: %v", err)
+		return 1
+	}
+	for _, problem := range report.Problems {
+		log.Print(problem)
+	}
+	log.Printf("Verified %d signature ledger entries with head %s, %d problems found", report.Entries, report.Head, len(report.Problems))
+	if len(report.Problems) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package dao
+
+import (
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+// insertAuditEvent appends the event to the audit log within the db transaction of the audited mutation, so that
+// either both or none of them are stored
+func insertAuditEvent(tx *sql.Tx, event *model.AuditEvent) error {
+	stmt, err := tx.Prepare("INSERT INTO audit_events (action, multisig_tx_id, alias, deposit_offer_id, actor, signature, request_id, client_ip, payload_hash, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(event.Action, nullString(event.MultisigTxId), nullString(event.Alias), nullString(event.DepositOfferId), event.Actor,
+		nullString(event.Signature), nullString(event.RequestId), nullString(event.ClientIp), nullString(event.PayloadHash), time.Now().UTC())
+	return err
+}
+
+// GetAuditEvents returns the audit events of the multisig txs of the alias, newest first, together with
+// the total number of events
+func (d *multisigTxDao) GetAuditEvents(alias string, limit int, offset int) (*[]model.AuditEvent, int, error) {
+	var total int
+	err := d.db.QueryRow("SELECT count(*) FROM audit_events WHERE alias = ?", alias).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := "SELECT id, action, multisig_tx_id, alias, actor, signature, request_id, client_ip, payload_hash, created_at " +
+		"FROM audit_events WHERE alias = ? ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?"
+	rows, err := d.db.Query(query, alias, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.AuditEvent, 0)
+	for rows.Next() {
+		var (
+			id           int64
+			action       string
+			multisigTxId sql.NullString
+			eventAlias   sql.NullString
+			actor        string
+			signature    sql.NullString
+			requestId    sql.NullString
+			clientIp     sql.NullString
+			payloadHash  sql.NullString
+			createdAt    time.Time
+		)
+		err = rows.Scan(&id, &action, &multisigTxId, &eventAlias, &actor, &signature, &requestId, &clientIp, &payloadHash, &createdAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		t := createdAt.UTC()
+		result = append(result, model.AuditEvent{
+			Id:           id,
+			Action:       model.AuditAction(action),
+			MultisigTxId: multisigTxId.String,
+			Alias:        eventAlias.String,
+			Actor:        actor,
+			Signature:    signature.String,
+			RequestId:    requestId.String,
+			ClientIp:     clientIp.String,
+			PayloadHash:  payloadHash.String,
+			Timestamp:    &t,
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+	return &result, total, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
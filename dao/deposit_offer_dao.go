@@ -10,7 +10,7 @@ import (
 var _ DepositOfferDao = (*depositOfferDao)(nil)
 
 type DepositOfferDao interface {
-	AddSignatures(depositOfferID string, addresses, signatures []string) error
+	AddSignatures(depositOfferID string, addresses, signatures []string, events []*model.AuditEvent) error
 	GetSignatures(address string) (*[]model.DepositOfferSig, error)
 }
 type depositOfferDao struct {
@@ -29,7 +29,9 @@ func NewDepositOfferDao(db *db.Db) DepositOfferDao {
 	}
 
 }
-func (d *depositOfferDao) AddSignatures(depositOfferID string, addresses []string, signatures []string) error {
+
+// AddSignatures stores the signatures together with their audit events and ledger entries in a single db transaction
+func (d *depositOfferDao) AddSignatures(depositOfferID string, addresses []string, signatures []string, events []*model.AuditEvent) error {
 	tx, err := d.db.Begin()
 	if err != nil {
 		return err
@@ -44,11 +46,22 @@ func (d *depositOfferDao) AddSignatures(depositOfferID string, addresses []strin
 		}
 	}()
 
+	ledgerEntries := make([]*model.LedgerEntry, 0, len(addresses))
 	for i, address := range addresses {
-		_, err = d.preparedInsert.Exec(depositOfferID, address, signatures[i])
+		_, err = tx.Stmt(d.preparedInsert).Exec(depositOfferID, address, signatures[i])
+		if err != nil {
+			return err
+		}
+		err = insertAuditEvent(tx, events[i])
 		if err != nil {
 			return err
 		}
+		ledgerEntries = append(ledgerEntries, signLedgerEntry(model.LedgerTableDepositOfferSigs, depositOfferID, address, signatures[i]))
+	}
+
+	err = appendLedgerEntries(tx, ledgerEntries)
+	if err != nil {
+		return err
 	}
 
 	err = tx.Commit()
@@ -35,17 +35,17 @@ func (m *MockDepositOfferDao) EXPECT() *MockDepositOfferDaoMockRecorder {
 }
 
 // AddSignatures mocks base method.
-func (m *MockDepositOfferDao) AddSignatures(arg0 string, arg1, arg2 []string) error {
+func (m *MockDepositOfferDao) AddSignatures(arg0 string, arg1, arg2 []string, arg3 []*model.AuditEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSignatures", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AddSignatures", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddSignatures indicates an expected call of AddSignatures.
-func (mr *MockDepositOfferDaoMockRecorder) AddSignatures(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockDepositOfferDaoMockRecorder) AddSignatures(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignatures", reflect.TypeOf((*MockDepositOfferDao)(nil).AddSignatures), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignatures", reflect.TypeOf((*MockDepositOfferDao)(nil).AddSignatures), arg0, arg1, arg2, arg3)
 }
 
 // GetSignatures mocks base method.
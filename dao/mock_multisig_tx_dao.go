@@ -35,63 +35,257 @@ func (m *MockMultisigTxDao) EXPECT() *MockMultisigTxDaoMockRecorder {
 	return m.recorder
 }
 
+// AddCancelVote mocks base method.
+func (m *MockMultisigTxDao) AddCancelVote(arg0, arg1 string, arg2 *model.AuditEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCancelVote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCancelVote indicates an expected call of AddCancelVote.
+func (mr *MockMultisigTxDaoMockRecorder) AddCancelVote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCancelVote", reflect.TypeOf((*MockMultisigTxDao)(nil).AddCancelVote), arg0, arg1, arg2)
+}
+
+// AddRejection mocks base method.
+func (m *MockMultisigTxDao) AddRejection(arg0, arg1, arg2 string, arg3 *model.AuditEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRejection", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRejection indicates an expected call of AddRejection.
+func (mr *MockMultisigTxDaoMockRecorder) AddRejection(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRejection", reflect.TypeOf((*MockMultisigTxDao)(nil).AddRejection), arg0, arg1, arg2, arg3)
+}
+
 // AddSigner mocks base method.
-func (m *MockMultisigTxDao) AddSigner(arg0, arg1, arg2 string) (bool, error) {
+func (m *MockMultisigTxDao) AddSigner(arg0, arg1, arg2 string, arg3 *model.AuditEvent) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSigner", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AddSigner", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddSigner indicates an expected call of AddSigner.
-func (mr *MockMultisigTxDaoMockRecorder) AddSigner(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockMultisigTxDaoMockRecorder) AddSigner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSigner", reflect.TypeOf((*MockMultisigTxDao)(nil).AddSigner), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSigner", reflect.TypeOf((*MockMultisigTxDao)(nil).AddSigner), arg0, arg1, arg2, arg3)
 }
 
-// CreateMultisigTx mocks base method.
-func (m *MockMultisigTxDao) CreateMultisigTx(arg0 *model.MultisigTx) (string, error) {
+// AddSigners mocks base method.
+func (m *MockMultisigTxDao) AddSigners(arg0 []model.MultisigTxOwner, arg1 []*model.AuditEvent) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMultisigTx", arg0)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "AddSigners", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMultisigTx indicates an expected call of CreateMultisigTx.
-func (mr *MockMultisigTxDaoMockRecorder) CreateMultisigTx(arg0 interface{}) *gomock.Call {
+// AddSigners indicates an expected call of AddSigners.
+func (mr *MockMultisigTxDaoMockRecorder) AddSigners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSigners", reflect.TypeOf((*MockMultisigTxDao)(nil).AddSigners), arg0, arg1)
+}
+
+// AppendLedgerEntries mocks base method.
+func (m *MockMultisigTxDao) AppendLedgerEntries(arg0 []*model.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendLedgerEntries", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendLedgerEntries indicates an expected call of AppendLedgerEntries.
+func (mr *MockMultisigTxDaoMockRecorder) AppendLedgerEntries(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTx", reflect.TypeOf((*MockMultisigTxDao)(nil).CreateMultisigTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendLedgerEntries", reflect.TypeOf((*MockMultisigTxDao)(nil).AppendLedgerEntries), arg0)
 }
 
-// DeletePendingTx mocks base method.
-func (m *MockMultisigTxDao) DeletePendingTx(arg0 string) (bool, error) {
+// ArchiveTx mocks base method.
+func (m *MockMultisigTxDao) ArchiveTx(arg0 string, arg1 *model.AuditEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePendingTx", arg0)
+	ret := m.ctrl.Call(m, "ArchiveTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveTx indicates an expected call of ArchiveTx.
+func (mr *MockMultisigTxDaoMockRecorder) ArchiveTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTx", reflect.TypeOf((*MockMultisigTxDao)(nil).ArchiveTx), arg0, arg1)
+}
+
+// CancelTx mocks base method.
+func (m *MockMultisigTxDao) CancelTx(arg0 string, arg1 model.MultisigTxState, arg2, arg3 string, arg4 *model.AuditEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTx", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeletePendingTx indicates an expected call of DeletePendingTx.
-func (mr *MockMultisigTxDaoMockRecorder) DeletePendingTx(arg0 interface{}) *gomock.Call {
+// CancelTx indicates an expected call of CancelTx.
+func (mr *MockMultisigTxDaoMockRecorder) CancelTx(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTx", reflect.TypeOf((*MockMultisigTxDao)(nil).CancelTx), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateMultisigTx mocks base method.
+func (m *MockMultisigTxDao) CreateMultisigTx(arg0 *model.MultisigTx, arg1 *model.AuditEvent) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultisigTx", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultisigTx indicates an expected call of CreateMultisigTx.
+func (mr *MockMultisigTxDaoMockRecorder) CreateMultisigTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingTx", reflect.TypeOf((*MockMultisigTxDao)(nil).DeletePendingTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTx", reflect.TypeOf((*MockMultisigTxDao)(nil).CreateMultisigTx), arg0, arg1)
 }
 
-// DeleteTxOwnersAndUpdateID mocks base method.
-func (m *MockMultisigTxDao) DeleteTxOwnersAndUpdateID(arg0, arg1 string) error {
+// DeleteOutboxEvent mocks base method.
+func (m *MockMultisigTxDao) DeleteOutboxEvent(arg0 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTxOwnersAndUpdateID", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteOutboxEvent", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTxOwnersAndUpdateID indicates an expected call of DeleteTxOwnersAndUpdateID.
-func (mr *MockMultisigTxDaoMockRecorder) DeleteTxOwnersAndUpdateID(arg0, arg1 interface{}) *gomock.Call {
+// DeleteOutboxEvent indicates an expected call of DeleteOutboxEvent.
+func (mr *MockMultisigTxDaoMockRecorder) DeleteOutboxEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutboxEvent", reflect.TypeOf((*MockMultisigTxDao)(nil).DeleteOutboxEvent), arg0)
+}
+
+// GetActiveTx mocks base method.
+func (m *MockMultisigTxDao) GetActiveTx() (*[]model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTx")
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTx indicates an expected call of GetActiveTx.
+func (mr *MockMultisigTxDaoMockRecorder) GetActiveTx() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTx", reflect.TypeOf((*MockMultisigTxDao)(nil).GetActiveTx))
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockMultisigTxDao) GetAuditEvents(arg0 string, arg1, arg2 int) (*[]model.AuditEvent, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*[]model.AuditEvent)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockMultisigTxDaoMockRecorder) GetAuditEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockMultisigTxDao)(nil).GetAuditEvents), arg0, arg1, arg2)
+}
+
+// GetChildTxIds mocks base method.
+func (m *MockMultisigTxDao) GetChildTxIds(arg0 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildTxIds", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildTxIds indicates an expected call of GetChildTxIds.
+func (mr *MockMultisigTxDaoMockRecorder) GetChildTxIds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildTxIds", reflect.TypeOf((*MockMultisigTxDao)(nil).GetChildTxIds), arg0)
+}
+
+// GetConflictingTxIds mocks base method.
+func (m *MockMultisigTxDao) GetConflictingTxIds(arg0 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConflictingTxIds", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConflictingTxIds indicates an expected call of GetConflictingTxIds.
+func (mr *MockMultisigTxDaoMockRecorder) GetConflictingTxIds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConflictingTxIds", reflect.TypeOf((*MockMultisigTxDao)(nil).GetConflictingTxIds), arg0)
+}
+
+// GetExpiredTx mocks base method.
+func (m *MockMultisigTxDao) GetExpiredTx() (*[]model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredTx")
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredTx indicates an expected call of GetExpiredTx.
+func (mr *MockMultisigTxDaoMockRecorder) GetExpiredTx() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredTx", reflect.TypeOf((*MockMultisigTxDao)(nil).GetExpiredTx))
+}
+
+// GetLedgerEntries mocks base method.
+func (m *MockMultisigTxDao) GetLedgerEntries(arg0 int64, arg1 int) (*[]model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerEntries", arg0, arg1)
+	ret0, _ := ret[0].(*[]model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerEntries indicates an expected call of GetLedgerEntries.
+func (mr *MockMultisigTxDaoMockRecorder) GetLedgerEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerEntries", reflect.TypeOf((*MockMultisigTxDao)(nil).GetLedgerEntries), arg0, arg1)
+}
+
+// GetLedgerHead mocks base method.
+func (m *MockMultisigTxDao) GetLedgerHead() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerHead")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerHead indicates an expected call of GetLedgerHead.
+func (mr *MockMultisigTxDaoMockRecorder) GetLedgerHead() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTxOwnersAndUpdateID", reflect.TypeOf((*MockMultisigTxDao)(nil).DeleteTxOwnersAndUpdateID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerHead", reflect.TypeOf((*MockMultisigTxDao)(nil).GetLedgerHead))
+}
+
+// GetLedgerProofEntries mocks base method.
+func (m *MockMultisigTxDao) GetLedgerProofEntries(arg0 string) (*[]model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerProofEntries", arg0)
+	ret0, _ := ret[0].(*[]model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerProofEntries indicates an expected call of GetLedgerProofEntries.
+func (mr *MockMultisigTxDaoMockRecorder) GetLedgerProofEntries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerProofEntries", reflect.TypeOf((*MockMultisigTxDao)(nil).GetLedgerProofEntries), arg0)
 }
 
 // GetMultisigTx mocks base method.
@@ -109,47 +303,211 @@ func (mr *MockMultisigTxDaoMockRecorder) GetMultisigTx(arg0, arg1, arg2, arg3 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigTx", reflect.TypeOf((*MockMultisigTxDao)(nil).GetMultisigTx), arg0, arg1, arg2, arg3)
 }
 
-// PendingAliasExists mocks base method.
-func (m *MockMultisigTxDao) PendingAliasExists(arg0, arg1 string) (bool, error) {
+// GetMultisigTxHistory mocks base method.
+func (m *MockMultisigTxDao) GetMultisigTxHistory(arg0, arg1 string, arg2, arg3 int) (*[]model.MultisigTx, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PendingAliasExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetMultisigTxHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMultisigTxHistory indicates an expected call of GetMultisigTxHistory.
+func (mr *MockMultisigTxDaoMockRecorder) GetMultisigTxHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigTxHistory", reflect.TypeOf((*MockMultisigTxDao)(nil).GetMultisigTxHistory), arg0, arg1, arg2, arg3)
+}
+
+// GetPendingOutboxEvents mocks base method.
+func (m *MockMultisigTxDao) GetPendingOutboxEvents(arg0 int) (*[]model.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOutboxEvents", arg0)
+	ret0, _ := ret[0].(*[]model.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOutboxEvents indicates an expected call of GetPendingOutboxEvents.
+func (mr *MockMultisigTxDaoMockRecorder) GetPendingOutboxEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOutboxEvents", reflect.TypeOf((*MockMultisigTxDao)(nil).GetPendingOutboxEvents), arg0)
+}
+
+// GetPendingTxForOwner mocks base method.
+func (m *MockMultisigTxDao) GetPendingTxForOwner(arg0 string) (*[]model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingTxForOwner", arg0)
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTxForOwner indicates an expected call of GetPendingTxForOwner.
+func (mr *MockMultisigTxDaoMockRecorder) GetPendingTxForOwner(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTxForOwner", reflect.TypeOf((*MockMultisigTxDao)(nil).GetPendingTxForOwner), arg0)
+}
+
+// GetStoredSignatures mocks base method.
+func (m *MockMultisigTxDao) GetStoredSignatures() (*[]model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredSignatures")
+	ret0, _ := ret[0].(*[]model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredSignatures indicates an expected call of GetStoredSignatures.
+func (mr *MockMultisigTxDaoMockRecorder) GetStoredSignatures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredSignatures", reflect.TypeOf((*MockMultisigTxDao)(nil).GetStoredSignatures))
+}
+
+// GetUnsettledIssuedTx mocks base method.
+func (m *MockMultisigTxDao) GetUnsettledIssuedTx() (*[]model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsettledIssuedTx")
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsettledIssuedTx indicates an expected call of GetUnsettledIssuedTx.
+func (mr *MockMultisigTxDaoMockRecorder) GetUnsettledIssuedTx() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsettledIssuedTx", reflect.TypeOf((*MockMultisigTxDao)(nil).GetUnsettledIssuedTx))
+}
+
+// MoveOutboxEventToDeadLetters mocks base method.
+func (m *MockMultisigTxDao) MoveOutboxEventToDeadLetters(arg0 *model.OutboxEvent, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOutboxEventToDeadLetters", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveOutboxEventToDeadLetters indicates an expected call of MoveOutboxEventToDeadLetters.
+func (mr *MockMultisigTxDaoMockRecorder) MoveOutboxEventToDeadLetters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOutboxEventToDeadLetters", reflect.TypeOf((*MockMultisigTxDao)(nil).MoveOutboxEventToDeadLetters), arg0, arg1)
+}
+
+// PurgeArchivedTx mocks base method.
+func (m *MockMultisigTxDao) PurgeArchivedTx(arg0 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeArchivedTx", arg0)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PendingAliasExists indicates an expected call of PendingAliasExists.
-func (mr *MockMultisigTxDaoMockRecorder) PendingAliasExists(arg0, arg1 interface{}) *gomock.Call {
+// PurgeArchivedTx indicates an expected call of PurgeArchivedTx.
+func (mr *MockMultisigTxDaoMockRecorder) PurgeArchivedTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeArchivedTx", reflect.TypeOf((*MockMultisigTxDao)(nil).PurgeArchivedTx), arg0)
+}
+
+// RecordOutboxFailure mocks base method.
+func (m *MockMultisigTxDao) RecordOutboxFailure(arg0 int64, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOutboxFailure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOutboxFailure indicates an expected call of RecordOutboxFailure.
+func (mr *MockMultisigTxDaoMockRecorder) RecordOutboxFailure(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingAliasExists", reflect.TypeOf((*MockMultisigTxDao)(nil).PendingAliasExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutboxFailure", reflect.TypeOf((*MockMultisigTxDao)(nil).RecordOutboxFailure), arg0, arg1)
 }
 
 // UpdateExpirationDate mocks base method.
-func (m *MockMultisigTxDao) UpdateExpirationDate(arg0 string, arg1 time.Time) (bool, error) {
+func (m *MockMultisigTxDao) UpdateExpirationDate(arg0 string, arg1 time.Time, arg2 string, arg3 *model.AuditEvent) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateExpirationDate", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateExpirationDate", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateExpirationDate indicates an expected call of UpdateExpirationDate.
-func (mr *MockMultisigTxDaoMockRecorder) UpdateExpirationDate(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockMultisigTxDaoMockRecorder) UpdateExpirationDate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpirationDate", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateExpirationDate), arg0, arg1, arg2, arg3)
+}
+
+// UpdateState mocks base method.
+func (m *MockMultisigTxDao) UpdateState(arg0 string, arg1, arg2 model.MultisigTxState) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateState indicates an expected call of UpdateState.
+func (mr *MockMultisigTxDaoMockRecorder) UpdateState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateState), arg0, arg1, arg2)
+}
+
+// UpdateStateWithReason mocks base method.
+func (m *MockMultisigTxDao) UpdateStateWithReason(arg0 string, arg1, arg2 model.MultisigTxState, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStateWithReason", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStateWithReason indicates an expected call of UpdateStateWithReason.
+func (mr *MockMultisigTxDaoMockRecorder) UpdateStateWithReason(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpirationDate", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateExpirationDate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStateWithReason", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateStateWithReason), arg0, arg1, arg2, arg3)
 }
 
 // UpdateTransactionId mocks base method.
-func (m *MockMultisigTxDao) UpdateTransactionId(arg0, arg1 string) (bool, error) {
+func (m *MockMultisigTxDao) UpdateTransactionId(arg0, arg1, arg2 string, arg3 *model.AuditEvent) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransactionId", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateTransactionId", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateTransactionId indicates an expected call of UpdateTransactionId.
-func (mr *MockMultisigTxDaoMockRecorder) UpdateTransactionId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockMultisigTxDaoMockRecorder) UpdateTransactionId(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionId", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateTransactionId), arg0, arg1, arg2, arg3)
+}
+
+// UpdateTxStatus mocks base method.
+func (m *MockMultisigTxDao) UpdateTxStatus(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTxStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTxStatus indicates an expected call of UpdateTxStatus.
+func (mr *MockMultisigTxDaoMockRecorder) UpdateTxStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTxStatus", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateTxStatus), arg0, arg1, arg2)
+}
+
+// WithdrawSigner mocks base method.
+func (m *MockMultisigTxDao) WithdrawSigner(arg0, arg1 string, arg2 *model.AuditEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawSigner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawSigner indicates an expected call of WithdrawSigner.
+func (mr *MockMultisigTxDaoMockRecorder) WithdrawSigner(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionId", reflect.TypeOf((*MockMultisigTxDao)(nil).UpdateTransactionId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawSigner", reflect.TypeOf((*MockMultisigTxDao)(nil).WithdrawSigner), arg0, arg1, arg2)
 }
@@ -8,6 +8,7 @@ package dao
 import (
 	"database/sql"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/chain4travel/camino-signavault/db"
@@ -15,14 +16,38 @@ import (
 )
 
 type MultisigTxDao interface {
-	CreateMultisigTx(multisig *model.MultisigTx) (string, error)
+	CreateMultisigTx(multisig *model.MultisigTx, event *model.AuditEvent) (string, error)
 	GetMultisigTx(id string, alias string, owner string, activeOnly bool) (*[]model.MultisigTx, error)
-	UpdateTransactionId(id string, transactionId string) (bool, error)
-	UpdateExpirationDate(id string, expirationDate time.Time) (bool, error)
-	AddSigner(id string, signature string, signerAddress string) (bool, error)
-	PendingAliasExists(alias string, chainId string) (bool, error)
-	DeletePendingTx(id string) (bool, error)
-	DeleteTxOwnersAndUpdateID(id, newId string) error
+	GetMultisigTxHistory(alias string, owner string, limit int, offset int) (*[]model.MultisigTx, int, error)
+	GetPendingTxForOwner(owner string) (*[]model.MultisigTx, error)
+	UpdateTransactionId(id string, transactionId string, issuer string, event *model.AuditEvent) (bool, error)
+	GetUnsettledIssuedTx() (*[]model.MultisigTx, error)
+	GetActiveTx() (*[]model.MultisigTx, error)
+	GetExpiredTx() (*[]model.MultisigTx, error)
+	UpdateTxStatus(id string, txStatus string, reason string) (bool, error)
+	UpdateExpirationDate(id string, expirationDate time.Time, extendedBy string, event *model.AuditEvent) (bool, error)
+	AddSigner(id string, signature string, signerAddress string, event *model.AuditEvent) (bool, error)
+	AddSigners(signers []model.MultisigTxOwner, events []*model.AuditEvent) (bool, error)
+	AddRejection(id string, rejection string, ownerAddress string, event *model.AuditEvent) (bool, error)
+	AddCancelVote(id string, ownerAddress string, event *model.AuditEvent) (bool, error)
+	WithdrawSigner(id string, signerAddress string, event *model.AuditEvent) (bool, error)
+	GetConflictingTxIds(utxoIds []string) ([]string, error)
+	GetChildTxIds(parentTransactions []string) ([]string, error)
+	UpdateState(id string, from model.MultisigTxState, to model.MultisigTxState) (bool, error)
+	UpdateStateWithReason(id string, from model.MultisigTxState, to model.MultisigTxState, reason string) (bool, error)
+	CancelTx(id string, from model.MultisigTxState, cancelledBy string, reason string, event *model.AuditEvent) (bool, error)
+	ArchiveTx(id string, event *model.AuditEvent) error
+	PurgeArchivedTx(archivedBefore time.Time) (int64, error)
+	GetAuditEvents(alias string, limit int, offset int) (*[]model.AuditEvent, int, error)
+	AppendLedgerEntries(entries []*model.LedgerEntry) error
+	GetLedgerEntries(afterId int64, limit int) (*[]model.LedgerEntry, error)
+	GetLedgerProofEntries(id string) (*[]model.LedgerEntry, error)
+	GetLedgerHead() (string, error)
+	GetStoredSignatures() (*[]model.LedgerEntry, error)
+	GetPendingOutboxEvents(limit int) (*[]model.OutboxEvent, error)
+	DeleteOutboxEvent(id int64) error
+	RecordOutboxFailure(id int64, lastError string) error
+	MoveOutboxEventToDeadLetters(event *model.OutboxEvent, lastError string) error
 }
 type multisigTxDao struct {
 	db *db.Db
@@ -34,13 +59,26 @@ func NewMultisigTxDao(db *db.Db) MultisigTxDao {
 	}
 }
 
-func (d *multisigTxDao) PendingAliasExists(alias string, chainId string) (bool, error) {
-	query := "SELECT count(id) " +
-		"FROM multisig_tx " +
-		"WHERE alias = ? AND chain_id = ? AND transaction_id IS NULL AND (expires_at > UTC_TIMESTAMP() OR expires_at IS NULL)"
-	rows, err := d.db.Query(query, alias, chainId)
+// GetConflictingTxIds returns the ids of the active or issued txs which spend at least one of the given utxos
+func (d *multisigTxDao) GetConflictingTxIds(utxoIds []string) ([]string, error) {
+	txIds := make([]string, 0)
+	if len(utxoIds) == 0 {
+		return txIds, nil
+	}
+
+	args := make([]interface{}, 0, len(utxoIds))
+	for _, utxoId := range utxoIds {
+		args = append(args, utxoId)
+	}
+	query := "SELECT DISTINCT tx.id " +
+		"FROM multisig_tx AS tx " +
+		"JOIN multisig_tx_inputs AS inputs ON inputs.multisig_tx_id = tx.id " +
+		"WHERE inputs.utxo_id IN (?" + strings.Repeat(", ?", len(utxoIds)-1) + ") " +
+		"AND (tx.state = 'issued' OR (tx.state IN ('pending', 'threshold_reached') AND (tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL))) " +
+		"ORDER BY tx.id"
+	rows, err := d.db.Query(query, args...)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer func(rows *sql.Rows) {
 		err := rows.Close()
@@ -49,27 +87,66 @@ func (d *multisigTxDao) PendingAliasExists(alias string, chainId string) (bool,
 		}
 	}(rows)
 
-	rows.Next()
-	var count int
-	err = rows.Scan(&count)
+	for rows.Next() {
+		var txId string
+		err = rows.Scan(&txId)
+		if err != nil {
+			return nil, err
+		}
+		txIds = append(txIds, txId)
+	}
+	return txIds, rows.Err()
+}
+
+// GetChildTxIds returns the ids of the txs whose parent transaction is one of the given references
+func (d *multisigTxDao) GetChildTxIds(parentTransactions []string) ([]string, error) {
+	txIds := make([]string, 0)
+	if len(parentTransactions) == 0 {
+		return txIds, nil
+	}
+
+	args := make([]interface{}, 0, len(parentTransactions))
+	for _, parentTransaction := range parentTransactions {
+		args = append(args, parentTransaction)
+	}
+	query := "SELECT id " +
+		"FROM multisig_tx " +
+		"WHERE parent_transaction IN (?" + strings.Repeat(", ?", len(parentTransactions)-1) + ") " +
+		"ORDER BY created_at ASC, id ASC"
+	rows, err := d.db.Query(query, args...)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	for rows.Next() {
+		var txId string
+		err = rows.Scan(&txId)
+		if err != nil {
+			return nil, err
+		}
+		txIds = append(txIds, txId)
 	}
-	return count > 0, nil
+	return txIds, rows.Err()
 }
 
-func (d *multisigTxDao) CreateMultisigTx(multisig *model.MultisigTx) (string, error) {
+func (d *multisigTxDao) CreateMultisigTx(multisig *model.MultisigTx, event *model.AuditEvent) (string, error) {
 	tx, err := d.db.Begin()
 	if err != nil {
 		return "", err
 	}
 
-	stmt, err := tx.Prepare("INSERT INTO multisig_tx (id, alias, threshold, chain_id, unsigned_tx, output_owners, metadata, parent_transaction, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	stmt, err := tx.Prepare("INSERT INTO multisig_tx (id, alias, threshold, chain_id, unsigned_tx, output_owners, metadata, parent_transaction, auto_issue, state, creator, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		return "", err
 	}
 	now := time.Now().UTC()
-	_, err = stmt.Exec(multisig.Id, multisig.Alias, multisig.Threshold, multisig.ChainId, multisig.UnsignedTx, multisig.OutputOwners, multisig.Metadata, multisig.ParentTransaction, multisig.Expiration, now)
+	_, err = stmt.Exec(multisig.Id, multisig.Alias, multisig.Threshold, multisig.ChainId, multisig.UnsignedTx, multisig.OutputOwners, multisig.Metadata, multisig.ParentTransaction, multisig.AutoIssue, multisig.State, sql.NullString{String: multisig.Creator, Valid: multisig.Creator != ""}, multisig.Expiration, now)
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
@@ -79,6 +156,7 @@ func (d *multisigTxDao) CreateMultisigTx(multisig *model.MultisigTx) (string, er
 	}
 
 	owners := multisig.Owners
+	ledgerEntries := make([]*model.LedgerEntry, 0, len(owners))
 	for _, owner := range owners {
 		stmt, err := tx.Prepare("INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at) VALUES (?, ?, ?, ?, ?)")
 		if err != nil {
@@ -92,8 +170,51 @@ func (d *multisigTxDao) CreateMultisigTx(multisig *model.MultisigTx) (string, er
 			log.Print(err)
 			return "", err
 		}
+		if owner.Signature != "" {
+			ledgerEntries = append(ledgerEntries, signLedgerEntry(model.LedgerTableMultisigTxOwners, multisig.Id, owner.Address, owner.Signature))
+		}
+	}
+
+	nodeIndex := 0
+	err = insertOwnerTree(tx, multisig.Id, multisig.OwnerTree, sql.NullInt64{}, &nodeIndex)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return "", err
+	}
+
+	for _, utxoId := range multisig.InputIds {
+		stmt, err := tx.Prepare("INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id) VALUES (?, ?)")
+		if err != nil {
+			return "", err
+		}
+		_, err = stmt.Exec(multisig.Id, utxoId)
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+			return "", err
+		}
+	}
 
+	err = appendLedgerEntries(tx, ledgerEntries)
+	if err == nil {
+		err = insertAuditEvent(tx, event)
+	}
+	if err == nil {
+		err = insertOutboxEvent(tx, model.MultisigTxEventCreated, multisig.Id, multisig.Creator)
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return "", err
 	}
+
 	err = tx.Commit()
 	if err != nil {
 		log.Printf("Commit failed: %v", err)
@@ -102,6 +223,30 @@ func (d *multisigTxDao) CreateMultisigTx(multisig *model.MultisigTx) (string, er
 
 	return multisig.Id, nil
 }
+
+// insertOwnerTree stores the nodes and their nested owners in depth-first order, referencing the parent
+// of each node by its index
+func insertOwnerTree(tx *sql.Tx, id string, nodes []model.OwnerNode, parentIndex sql.NullInt64, nodeIndex *int) error {
+	for _, node := range nodes {
+		stmt, err := tx.Prepare("INSERT INTO multisig_tx_owner_tree (multisig_tx_id, node_index, parent_index, address, threshold) VALUES (?, ?, ?, ?, ?)")
+		if err != nil {
+			return err
+		}
+		threshold := sql.NullInt64{Int64: int64(node.Threshold), Valid: len(node.Owners) > 0}
+		_, err = stmt.Exec(id, *nodeIndex, parentIndex, node.Address, threshold)
+		if err != nil {
+			return err
+		}
+		index := sql.NullInt64{Int64: int64(*nodeIndex), Valid: true}
+		*nodeIndex++
+		err = insertOwnerTree(tx, id, node.Owners, index, nodeIndex)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *multisigTxDao) GetActiveMultisigTx(id string, alias string, owner string) (*[]model.MultisigTx, error) {
 	return d.GetMultisigTx(id, alias, owner, true)
 }
@@ -111,7 +256,7 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 
 	expiredCondition := ""
 	if activeOnly {
-		expiredCondition = "AND (tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL)"
+		expiredCondition = "AND tx.state IN ('pending', 'threshold_reached') AND (tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL)"
 	}
 
 	var query string
@@ -126,15 +271,32 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 			"tx.output_owners," +
 			"tx.metadata," +
 			"tx.parent_transaction," +
+			"tx.auto_issue," +
+			"tx.state," +
+			"tx.state_updated_at," +
+			"tx.state_reason," +
+			"tx.creator," +
+			"tx.cancelled_by," +
+			"tx.issuer," +
 			"tx.expires_at," +
+			"tx.extended_by," +
+			"tx.extended_at," +
+			"tx.issued_at," +
+			"tx.tx_status," +
+			"tx.tx_status_reason," +
+			"tx.tx_status_updated_at," +
 			"tx.created_at," +
 			"owners.multisig_tx_id, " +
 			"owners.address, " +
 			"owners.signature, " +
-			"owners.is_signer " +
+			"owners.is_signer, " +
+			"owners.withdrawn_at, " +
+			"owners.rejection, " +
+			"owners.rejected_at, " +
+			"owners.cancel_voted_at " +
 			"FROM multisig_tx AS tx " +
 			"LEFT JOIN multisig_tx_owners AS owners ON tx.id = owners.multisig_tx_id " +
-			"WHERE (tx.alias=? OR ?='') AND (tx.id=? OR ?='') AND tx.transaction_id IS NULL " + expiredCondition +
+			"WHERE (tx.alias=? OR ?='') AND (tx.id=? OR ?='') " + expiredCondition +
 			"ORDER BY tx.created_at ASC"
 		rows, err = d.db.Query(query, alias, alias, id, id)
 	} else {
@@ -147,17 +309,36 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 			"tx.output_owners," +
 			"tx.metadata," +
 			"tx.parent_transaction," +
+			"tx.auto_issue," +
+			"tx.state," +
+			"tx.state_updated_at," +
+			"tx.state_reason," +
+			"tx.creator," +
+			"tx.cancelled_by," +
+			"tx.issuer," +
 			"tx.expires_at," +
+			"tx.extended_by," +
+			"tx.extended_at," +
+			"tx.issued_at," +
+			"tx.tx_status," +
+			"tx.tx_status_reason," +
+			"tx.tx_status_updated_at," +
 			"tx.created_at," +
 			"owners.multisig_tx_id, " +
 			"owners.address, " +
 			"owners.signature, " +
 			"owners.is_signer, " +
+			"owners.withdrawn_at, " +
+			"owners.rejection, " +
+			"owners.rejected_at, " +
+			"owners.cancel_voted_at, " +
 			"owners2.address " +
 			"FROM multisig_tx AS tx " +
 			"LEFT JOIN multisig_tx_owners AS owners ON tx.id = owners.multisig_tx_id " +
 			"JOIN multisig_tx_owners AS owners2 ON tx.id = owners2.multisig_tx_id " +
-			"WHERE (tx.alias=? OR ?='') AND (tx.id=? OR ?='') AND (owners2.address = ? OR ?='') AND tx.transaction_id IS NULL AND (tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL)" +
+			"WHERE (tx.alias=? OR ?='') AND (tx.id=? OR ?='') AND (owners2.address = ? OR ?='') AND tx.state NOT IN ('expired', 'cancelled') " +
+			// the expiration only applies to txs which can still be signed, issued txs are listed with their status
+			"AND (tx.state NOT IN ('pending', 'threshold_reached') OR tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL) " +
 			"ORDER BY tx.created_at ASC"
 		rows, err = d.db.Query(query, alias, alias, id, id, owner, owner)
 	}
@@ -165,6 +346,61 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 	if err != nil {
 		return nil, err
 	}
+	return d.readMultisigTxs(rows, owner != "")
+}
+
+// GetPendingTxForOwner returns the pending txs of all aliases for which the given address is an owner who has
+// neither signed nor rejected the tx yet
+func (d *multisigTxDao) GetPendingTxForOwner(owner string) (*[]model.MultisigTx, error) {
+	query := "SELECT tx.id, " +
+		"tx.alias, " +
+		"tx.threshold, " +
+		"tx.chain_id, " +
+		"tx.transaction_id, " +
+		"tx.unsigned_tx, " +
+		"tx.output_owners," +
+		"tx.metadata," +
+		"tx.parent_transaction," +
+		"tx.auto_issue," +
+		"tx.state," +
+		"tx.state_updated_at," +
+		"tx.state_reason," +
+		"tx.creator," +
+		"tx.cancelled_by," +
+		"tx.issuer," +
+		"tx.expires_at," +
+		"tx.extended_by," +
+		"tx.extended_at," +
+		"tx.issued_at," +
+		"tx.tx_status," +
+		"tx.tx_status_reason," +
+		"tx.tx_status_updated_at," +
+		"tx.created_at," +
+		"owners.multisig_tx_id, " +
+		"owners.address, " +
+		"owners.signature, " +
+		"owners.is_signer, " +
+		"owners.withdrawn_at, " +
+		"owners.rejection, " +
+		"owners.rejected_at, " +
+		"owners.cancel_voted_at, " +
+		"owners2.address " +
+		"FROM multisig_tx AS tx " +
+		"LEFT JOIN multisig_tx_owners AS owners ON tx.id = owners.multisig_tx_id " +
+		"JOIN multisig_tx_owners AS owners2 ON tx.id = owners2.multisig_tx_id " +
+		"WHERE owners2.address = ? AND owners2.is_signer = FALSE AND owners2.rejected_at IS NULL " +
+		"AND tx.state = 'pending' AND (tx.expires_at > UTC_TIMESTAMP() OR tx.expires_at IS NULL) " +
+		"ORDER BY tx.created_at ASC"
+	rows, err := d.db.Query(query, owner)
+	if err != nil {
+		return nil, err
+	}
+	return d.readMultisigTxs(rows, true)
+}
+
+// readMultisigTxs reads the rows of a tx query joined with its owners into txs. If withOwnerJoin is set, the rows
+// have an additional column with the address of the owner the txs have been filtered by.
+func (d *multisigTxDao) readMultisigTxs(rows *sql.Rows, withOwnerJoin bool) (*[]model.MultisigTx, error) {
 	defer func(rows *sql.Rows) {
 		err := rows.Close()
 		if err != nil {
@@ -177,31 +413,48 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 
 	for rows.Next() {
 		var (
-			txId              string
-			txAlias           string
-			txThreshold       int8
-			txTransactionId   sql.NullString
-			txUnsignedTx      string
-			txChainId         string
-			txOutputOwners    string
-			txMetadata        string
-			txParentTx        sql.NullString
-			txExpiresAt       sql.NullTime
-			txCreatedAt       time.Time
-			ownerMultisigTxId string
-			ownerAddress      sql.NullString
-			ownerSignature    sql.NullString
-			ownerIsSigner     sql.NullBool
-			ownerAddress2     sql.NullString
+			txId               string
+			txAlias            string
+			txThreshold        int8
+			txTransactionId    sql.NullString
+			txUnsignedTx       string
+			txChainId          string
+			txOutputOwners     string
+			txMetadata         string
+			txParentTx         sql.NullString
+			txAutoIssue        bool
+			txState            string
+			txStateUpdatedAt   sql.NullTime
+			txStateReason      sql.NullString
+			txCreator          sql.NullString
+			txCancelledBy      sql.NullString
+			txIssuer           sql.NullString
+			txExpiresAt        sql.NullTime
+			txExtendedBy       sql.NullString
+			txExtendedAt       sql.NullTime
+			txIssuedAt         sql.NullTime
+			txStatus           sql.NullString
+			txStatusReason     sql.NullString
+			txStatusUpdatedAt  sql.NullTime
+			txCreatedAt        time.Time
+			ownerMultisigTxId  string
+			ownerAddress       sql.NullString
+			ownerSignature     sql.NullString
+			ownerIsSigner      sql.NullBool
+			ownerWithdrawnAt   sql.NullTime
+			ownerRejection     sql.NullString
+			ownerRejectedAt    sql.NullTime
+			ownerCancelVotedAt sql.NullTime
+			ownerAddress2      sql.NullString
 		)
 
 		var err error
-		if owner == "" {
+		if !withOwnerJoin {
 			err = rows.Scan(&txId, &txAlias, &txThreshold, &txChainId, &txTransactionId, &txUnsignedTx, &txOutputOwners,
-				&txMetadata, &txParentTx, &txExpiresAt, &txCreatedAt, &ownerMultisigTxId, &ownerAddress, &ownerSignature, &ownerIsSigner)
+				&txMetadata, &txParentTx, &txAutoIssue, &txState, &txStateUpdatedAt, &txStateReason, &txCreator, &txCancelledBy, &txIssuer, &txExpiresAt, &txExtendedBy, &txExtendedAt, &txIssuedAt, &txStatus, &txStatusReason, &txStatusUpdatedAt, &txCreatedAt, &ownerMultisigTxId, &ownerAddress, &ownerSignature, &ownerIsSigner, &ownerWithdrawnAt, &ownerRejection, &ownerRejectedAt, &ownerCancelVotedAt)
 		} else {
 			err = rows.Scan(&txId, &txAlias, &txThreshold, &txChainId, &txTransactionId, &txUnsignedTx, &txOutputOwners,
-				&txMetadata, &txParentTx, &txExpiresAt, &txCreatedAt, &ownerMultisigTxId, &ownerAddress, &ownerSignature, &ownerIsSigner, &ownerAddress2)
+				&txMetadata, &txParentTx, &txAutoIssue, &txState, &txStateUpdatedAt, &txStateReason, &txCreator, &txCancelledBy, &txIssuer, &txExpiresAt, &txExtendedBy, &txExtendedAt, &txIssuedAt, &txStatus, &txStatusReason, &txStatusUpdatedAt, &txCreatedAt, &ownerMultisigTxId, &ownerAddress, &ownerSignature, &ownerIsSigner, &ownerWithdrawnAt, &ownerRejection, &ownerRejectedAt, &ownerCancelVotedAt, &ownerAddress2)
 		}
 		if err != nil {
 			log.Fatal(err)
@@ -220,6 +473,22 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 			t := txCreatedAt.UTC()
 			created := &t
 
+			var issuedAt *time.Time
+			if txIssuedAt.Valid {
+				t := txIssuedAt.Time.UTC()
+				issuedAt = &t
+			}
+			var statusUpdatedAt *time.Time
+			if txStatusUpdatedAt.Valid {
+				t := txStatusUpdatedAt.Time.UTC()
+				statusUpdatedAt = &t
+			}
+			var stateUpdatedAt *time.Time
+			if txStateUpdatedAt.Valid {
+				t := txStateUpdatedAt.Time.UTC()
+				stateUpdatedAt = &t
+			}
+
 			tx = model.MultisigTx{
 				Id:                txId,
 				UnsignedTx:        txUnsignedTx,
@@ -230,7 +499,20 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 				OutputOwners:      txOutputOwners,
 				Metadata:          txMetadata,
 				ParentTransaction: txParentTx.String,
+				AutoIssue:         txAutoIssue,
+				State:             model.MultisigTxState(txState),
+				StateUpdatedAt:    stateUpdatedAt,
+				StateReason:       txStateReason.String,
+				Creator:           txCreator.String,
+				CancelledBy:       txCancelledBy.String,
+				Issuer:            txIssuer.String,
 				Expiration:        expiration,
+				ExtendedBy:        txExtendedBy.String,
+				ExtendedAt:        toUTC(txExtendedAt),
+				IssuedAt:          issuedAt,
+				TxStatus:          txStatus.String,
+				TxStatusReason:    txStatusReason.String,
+				TxStatusUpdatedAt: statusUpdatedAt,
 				Timestamp:         created,
 			}
 		}
@@ -241,9 +523,13 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 		}
 		// add owner
 		owner := model.MultisigTxOwner{
-			MultisigTxId: ownerMultisigTxId,
-			Address:      ownerAddress.String,
-			Signature:    ownerSignature.String,
+			MultisigTxId:  ownerMultisigTxId,
+			Address:       ownerAddress.String,
+			Signature:     ownerSignature.String,
+			WithdrawnAt:   toUTC(ownerWithdrawnAt),
+			Rejection:     ownerRejection.String,
+			RejectedAt:    toUTC(ownerRejectedAt),
+			CancelVotedAt: toUTC(ownerCancelVotedAt),
 		}
 		owners = append(owners, owner)
 		tx.Owners = owners
@@ -251,12 +537,16 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 		multiSigTx[txId] = tx
 
 	}
-	err = rows.Err()
+	err := rows.Err()
 	if err != nil {
 		log.Fatal(err)
 	}
 	// convert map to slice
 	for _, tx := range multiSigTx {
+		tx.OwnerTree, err = d.getOwnerTree("SELECT node_index, parent_index, address, threshold FROM multisig_tx_owner_tree WHERE multisig_tx_id = ? ORDER BY node_index", tx.Id)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, tx)
 	}
 	if result == nil {
@@ -265,112 +555,263 @@ func (d *multisigTxDao) GetMultisigTx(id string, alias string, owner string, act
 	return &result, nil
 }
 
-func (d *multisigTxDao) UpdateTransactionId(id string, transactionId string) (bool, error) {
-	tx, err := d.db.Begin()
+// GetMultisigTxHistory returns the txs of the alias that are not active anymore, including the archived ones,
+// for which the given address is an owner. The txs are ordered by creation date, newest first.
+func (d *multisigTxDao) GetMultisigTxHistory(alias string, owner string, limit int, offset int) (*[]model.MultisigTx, int, error) {
+	historyCondition := "tx.alias = ? AND (tx.state NOT IN ('pending', 'threshold_reached') OR tx.expires_at <= UTC_TIMESTAMP()) " +
+		"AND EXISTS (SELECT 1 FROM multisig_tx_owners AS owners WHERE owners.multisig_tx_id = tx.id AND owners.address = ?)"
+	archiveCondition := "tx.alias = ? " +
+		"AND EXISTS (SELECT 1 FROM multisig_tx_owners_archive AS owners WHERE owners.archive_id = tx.archive_id AND owners.address = ?)"
+
+	var total int
+	err := d.db.QueryRow("SELECT "+
+		"(SELECT count(*) FROM multisig_tx AS tx WHERE "+historyCondition+") + "+
+		"(SELECT count(*) FROM multisig_tx_archive AS tx WHERE "+archiveCondition+")",
+		alias, owner, alias, owner).Scan(&total)
 	if err != nil {
-		return false, err
+		return nil, 0, err
 	}
 
-	stmt, err := tx.Prepare("UPDATE multisig_tx SET transaction_id = ? WHERE id = ? AND transaction_id IS NULL")
+	columns := "tx.id, tx.alias, tx.threshold, tx.chain_id, tx.transaction_id, tx.unsigned_tx, tx.output_owners, tx.metadata, " +
+		"tx.parent_transaction, tx.auto_issue, tx.state, tx.state_updated_at, tx.state_reason, tx.creator, tx.cancelled_by, tx.issuer, tx.issued_at, tx.tx_status, " +
+		"tx.tx_status_reason, tx.tx_status_updated_at, tx.expires_at, tx.extended_by, tx.extended_at, tx.created_at"
+	query := "SELECT * FROM (" +
+		"SELECT NULL AS archive_id, " + columns + ", NULL AS archived_at FROM multisig_tx AS tx WHERE " + historyCondition +
+		" UNION ALL " +
+		"SELECT tx.archive_id, " + columns + ", tx.archived_at FROM multisig_tx_archive AS tx WHERE " + archiveCondition +
+		") AS history ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	rows, err := d.db.Query(query, alias, owner, alias, owner, limit, offset)
 	if err != nil {
-		return false, err
+		return nil, 0, err
 	}
-	_, err = stmt.Exec(transactionId, id)
-	if err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
 		}
-		log.Print(err)
-		return false, err
-	}
+	}(rows)
 
-	err = tx.Commit()
+	result := make([]model.MultisigTx, 0)
+	var archiveIds []sql.NullInt64
+	for rows.Next() {
+		var (
+			archiveId         sql.NullInt64
+			txId              string
+			txAlias           string
+			txThreshold       int8
+			txChainId         string
+			txTransactionId   sql.NullString
+			txUnsignedTx      string
+			txOutputOwners    string
+			txMetadata        string
+			txParentTx        sql.NullString
+			txAutoIssue       bool
+			txState           string
+			txStateUpdatedAt  sql.NullTime
+			txStateReason     sql.NullString
+			txCreator         sql.NullString
+			txCancelledBy     sql.NullString
+			txIssuer          sql.NullString
+			txIssuedAt        sql.NullTime
+			txStatus          sql.NullString
+			txStatusReason    sql.NullString
+			txStatusUpdatedAt sql.NullTime
+			txExpiresAt       sql.NullTime
+			txExtendedBy      sql.NullString
+			txExtendedAt      sql.NullTime
+			txCreatedAt       time.Time
+			txArchivedAt      sql.NullTime
+		)
+		err = rows.Scan(&archiveId, &txId, &txAlias, &txThreshold, &txChainId, &txTransactionId, &txUnsignedTx, &txOutputOwners,
+			&txMetadata, &txParentTx, &txAutoIssue, &txState, &txStateUpdatedAt, &txStateReason, &txCreator, &txCancelledBy, &txIssuer, &txIssuedAt, &txStatus,
+			&txStatusReason, &txStatusUpdatedAt, &txExpiresAt, &txExtendedBy, &txExtendedAt, &txCreatedAt, &txArchivedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		created := txCreatedAt.UTC()
+		result = append(result, model.MultisigTx{
+			Id:                txId,
+			UnsignedTx:        txUnsignedTx,
+			Alias:             txAlias,
+			Threshold:         txThreshold,
+			ChainId:           txChainId,
+			TransactionId:     txTransactionId.String,
+			OutputOwners:      txOutputOwners,
+			Metadata:          txMetadata,
+			ParentTransaction: txParentTx.String,
+			AutoIssue:         txAutoIssue,
+			State:             model.MultisigTxState(txState),
+			StateUpdatedAt:    toUTC(txStateUpdatedAt),
+			StateReason:       txStateReason.String,
+			Creator:           txCreator.String,
+			CancelledBy:       txCancelledBy.String,
+			Issuer:            txIssuer.String,
+			Expiration:        toUTC(txExpiresAt),
+			ExtendedBy:        txExtendedBy.String,
+			ExtendedAt:        toUTC(txExtendedAt),
+			IssuedAt:          toUTC(txIssuedAt),
+			TxStatus:          txStatus.String,
+			TxStatusReason:    txStatusReason.String,
+			TxStatusUpdatedAt: toUTC(txStatusUpdatedAt),
+			Owners:            []model.MultisigTxOwner{},
+			Timestamp:         &created,
+			ArchivedAt:        toUTC(txArchivedAt),
+		})
+		archiveIds = append(archiveIds, archiveId)
+	}
+	err = rows.Err()
 	if err != nil {
-		log.Printf("Commit failed: %v", err)
-		return false, err
+		return nil, 0, err
 	}
 
-	return true, nil
-}
+	for i := range result {
+		var owners *[]model.MultisigTxOwner
+		if archiveIds[i].Valid {
+			owners, err = d.getOwners("SELECT address, signature, withdrawn_at, rejection, rejected_at, cancel_voted_at FROM multisig_tx_owners_archive WHERE archive_id = ?", archiveIds[i].Int64)
+		} else {
+			owners, err = d.getOwners("SELECT address, signature, withdrawn_at, rejection, rejected_at, cancel_voted_at FROM multisig_tx_owners WHERE multisig_tx_id = ?", result[i].Id)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, owner := range *owners {
+			owner.MultisigTxId = result[i].Id
+			result[i].Owners = append(result[i].Owners, owner)
+		}
 
-func (d *multisigTxDao) UpdateExpirationDate(id string, expirationDate time.Time) (bool, error) {
-	tx, err := d.db.Begin()
-	if err != nil {
-		return false, err
+		if archiveIds[i].Valid {
+			result[i].OwnerTree, err = d.getOwnerTree("SELECT node_index, parent_index, address, threshold FROM multisig_tx_owner_tree_archive WHERE archive_id = ? ORDER BY node_index", archiveIds[i].Int64)
+		} else {
+			result[i].OwnerTree, err = d.getOwnerTree("SELECT node_index, parent_index, address, threshold FROM multisig_tx_owner_tree WHERE multisig_tx_id = ? ORDER BY node_index", result[i].Id)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
 	}
+	return &result, total, nil
+}
 
-	stmt, err := tx.Prepare("UPDATE multisig_tx SET expires_at = ? WHERE id = ? AND transaction_id IS NULL")
+func (d *multisigTxDao) getOwners(query string, key interface{}) (*[]model.MultisigTxOwner, error) {
+	rows, err := d.db.Query(query, key)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	_, err = stmt.Exec(expirationDate, id)
-	if err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
 		}
-		log.Print(err)
-		return false, err
-	}
+	}(rows)
 
-	err = tx.Commit()
-	if err != nil {
-		log.Printf("Commit failed: %v", err)
-		return false, err
+	owners := make([]model.MultisigTxOwner, 0)
+	for rows.Next() {
+		var (
+			ownerAddress       string
+			ownerSignature     sql.NullString
+			ownerWithdrawnAt   sql.NullTime
+			ownerRejection     sql.NullString
+			ownerRejectedAt    sql.NullTime
+			ownerCancelVotedAt sql.NullTime
+		)
+		err = rows.Scan(&ownerAddress, &ownerSignature, &ownerWithdrawnAt, &ownerRejection, &ownerRejectedAt, &ownerCancelVotedAt)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, model.MultisigTxOwner{
+			Address:       ownerAddress,
+			Signature:     ownerSignature.String,
+			WithdrawnAt:   toUTC(ownerWithdrawnAt),
+			Rejection:     ownerRejection.String,
+			RejectedAt:    toUTC(ownerRejectedAt),
+			CancelVotedAt: toUTC(ownerCancelVotedAt),
+		})
 	}
-
-	return true, nil
+	return &owners, rows.Err()
 }
 
-func (d *multisigTxDao) DeletePendingTx(id string) (bool, error) {
-	tx, err := d.db.Begin()
+// getOwnerTree rebuilds the owner tree from its nodes, which are stored in depth-first order.
+// It returns nil if the alias of the tx has no nested aliases.
+func (d *multisigTxDao) getOwnerTree(query string, key interface{}) ([]model.OwnerNode, error) {
+	rows, err := d.db.Query(query, key)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
 
-	// delete owners first
-	stmt, err := tx.Prepare("DELETE FROM multisig_tx_owners WHERE multisig_tx_id = ?")
-	if err != nil {
-		return false, err
+	type treeNode struct {
+		node        model.OwnerNode
+		index       int64
+		parentIndex sql.NullInt64
 	}
-	_, err = stmt.Exec(id)
-
-	if err == nil {
-		// delete tx
-		stmt, err = tx.Prepare("DELETE FROM multisig_tx WHERE id = ? AND transaction_id IS NULL")
+	var nodes []treeNode
+	for rows.Next() {
+		var (
+			node      treeNode
+			threshold sql.NullInt64
+		)
+		err = rows.Scan(&node.index, &node.parentIndex, &node.node.Address, &threshold)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
-		_, err = stmt.Exec(id)
+		node.node.Threshold = int8(threshold.Int64)
+		nodes = append(nodes, node)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	if err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+	var children func(parentIndex sql.NullInt64) []model.OwnerNode
+	children = func(parentIndex sql.NullInt64) []model.OwnerNode {
+		var result []model.OwnerNode
+		for _, n := range nodes {
+			if n.parentIndex == parentIndex {
+				node := n.node
+				node.Owners = children(sql.NullInt64{Int64: n.index, Valid: true})
+				result = append(result, node)
+			}
 		}
-		log.Print(err)
-		return false, err
+		return result
 	}
+	return children(sql.NullInt64{}), nil
+}
 
-	err = tx.Commit()
-	if err != nil {
-		log.Printf("Commit failed: %v", err)
-		return false, err
+func toUTC(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
 	}
-
-	return true, nil
+	utc := t.Time.UTC()
+	return &utc
 }
 
-func (d *multisigTxDao) AddSigner(id string, signature string, signerAddress string) (bool, error) {
+func (d *multisigTxDao) UpdateTransactionId(id string, transactionId string, issuer string, event *model.AuditEvent) (bool, error) {
 	tx, err := d.db.Begin()
 	if err != nil {
 		return false, err
 	}
 
-	stmt, err := tx.Prepare("UPDATE multisig_tx_owners SET signature = ?, is_signer = ? WHERE multisig_tx_id = ? AND address = ?")
+	stmt, err := tx.Prepare("UPDATE multisig_tx SET transaction_id = ?, issuer = ?, issued_at = ?, state = ?, state_updated_at = ? WHERE id = ? AND transaction_id IS NULL")
 	if err != nil {
 		return false, err
 	}
-	_, err = stmt.Exec(signature, true, id, signerAddress)
+	now := time.Now().UTC()
+	res, err := stmt.Exec(transactionId, issuer, now, model.MultisigTxStateIssued, now, id)
+
+	var issued int64
+	if err == nil {
+		issued, err = res.RowsAffected()
+	}
+	if err == nil && issued > 0 {
+		err = insertAuditEvent(tx, event)
+		if err == nil {
+			err = insertOutboxEvent(tx, model.MultisigTxEventIssued, id, issuer)
+		}
+	}
 	if err != nil {
 		if rollbackErr := tx.Rollback(); rollbackErr != nil {
 			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
@@ -378,51 +819,679 @@ func (d *multisigTxDao) AddSigner(id string, signature string, signerAddress str
 		log.Print(err)
 		return false, err
 	}
+	// the tx has already been issued, there is nothing to record
+	if issued == 0 {
+		if err = tx.Rollback(); err != nil {
+			log.Printf("Rollback failed: %v", err)
+		}
+		return false, nil
+	}
 
 	err = tx.Commit()
 	if err != nil {
 		log.Printf("Commit failed: %v", err)
 		return false, err
 	}
-
 	return true, nil
 }
 
-func (d *multisigTxDao) DeleteTxOwnersAndUpdateID(id, newId string) error {
-	tx, err := d.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	// delete owners first
-	stmt, err := tx.Prepare("DELETE FROM multisig_tx_owners WHERE multisig_tx_id = ?")
+func (d *multisigTxDao) GetUnsettledIssuedTx() (*[]model.MultisigTx, error) {
+	query := "SELECT id, alias, transaction_id, state, tx_status " +
+		"FROM multisig_tx " +
+		"WHERE state = 'issued' " +
+		"ORDER BY issued_at ASC"
+	rows, err := d.db.Query(query)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = stmt.Exec(id)
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
 
-	if err == nil {
-		// delete tx
-		stmt, err = tx.Prepare("UPDATE multisig_tx SET id =?  WHERE id = ?")
+	var result []model.MultisigTx
+	for rows.Next() {
+		var (
+			txId            string
+			txAlias         string
+			txTransactionId string
+			txState         string
+			txStatus        sql.NullString
+		)
+		err = rows.Scan(&txId, &txAlias, &txTransactionId, &txState, &txStatus)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		_, err = stmt.Exec(newId, id)
+		result = append(result, model.MultisigTx{
+			Id:            txId,
+			Alias:         txAlias,
+			TransactionId: txTransactionId,
+			State:         model.MultisigTxState(txState),
+			TxStatus:      txStatus.String,
+		})
 	}
-
+	err = rows.Err()
 	if err != nil {
-		if rollbackErr := tx.Rollback(); rollbackErr != nil {
-			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
-		}
-		log.Print(err)
-		return err
+		return nil, err
 	}
+	return &result, nil
+}
 
-	err = tx.Commit()
+// GetActiveTx returns the pending and threshold_reached txs which have not expired yet, without their owners
+func (d *multisigTxDao) GetActiveTx() (*[]model.MultisigTx, error) {
+	query := "SELECT id, unsigned_tx, alias, state " +
+		"FROM multisig_tx " +
+		"WHERE state IN ('pending', 'threshold_reached') AND (expires_at > UTC_TIMESTAMP() OR expires_at IS NULL) " +
+		"ORDER BY created_at ASC"
+	rows, err := d.db.Query(query)
 	if err != nil {
-		log.Printf("Commit failed: %v", err)
-		return err
+		return nil, err
 	}
-
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	var result []model.MultisigTx
+	for rows.Next() {
+		var (
+			txId         string
+			txUnsignedTx string
+			txAlias      string
+			txState      string
+		)
+		err = rows.Scan(&txId, &txUnsignedTx, &txAlias, &txState)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.MultisigTx{
+			Id:         txId,
+			UnsignedTx: txUnsignedTx,
+			Alias:      txAlias,
+			State:      model.MultisigTxState(txState),
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpiredTx returns the pending and threshold_reached txs whose expiration has passed, without their owners
+func (d *multisigTxDao) GetExpiredTx() (*[]model.MultisigTx, error) {
+	query := "SELECT id, alias, state, expires_at " +
+		"FROM multisig_tx " +
+		"WHERE state IN ('pending', 'threshold_reached') AND expires_at <= UTC_TIMESTAMP() " +
+		"ORDER BY expires_at ASC"
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	var result []model.MultisigTx
+	for rows.Next() {
+		var (
+			txId        string
+			txAlias     string
+			txState     string
+			txExpiresAt sql.NullTime
+		)
+		err = rows.Scan(&txId, &txAlias, &txState, &txExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.MultisigTx{
+			Id:         txId,
+			Alias:      txAlias,
+			State:      model.MultisigTxState(txState),
+			Expiration: toUTC(txExpiresAt),
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (d *multisigTxDao) UpdateTxStatus(id string, txStatus string, reason string) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx SET tx_status = ?, tx_status_reason = ?, tx_status_updated_at = ? WHERE id = ? AND state = 'issued'")
+	if err != nil {
+		return false, err
+	}
+	_, err = stmt.Exec(txStatus, reason, time.Now().UTC(), id)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpdateExpirationDate moves the expiration of an active tx and records who has extended it and when.
+// It returns false if the tx is not active anymore.
+func (d *multisigTxDao) UpdateExpirationDate(id string, expirationDate time.Time, extendedBy string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx SET expires_at = ?, extended_by = ?, extended_at = ? WHERE id = ? AND state IN ('pending', 'threshold_reached')")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(expirationDate, extendedBy, time.Now().UTC(), id)
+
+	var updated int64
+	if err == nil {
+		updated, err = res.RowsAffected()
+	}
+	if err == nil && updated > 0 {
+		err = insertAuditEvent(tx, event)
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+const addSignerQuery = "UPDATE multisig_tx_owners SET signature = ?, is_signer = ?, withdrawn_at = NULL WHERE multisig_tx_id = ? AND address = ?"
+
+// AddSigner stores the signature of the owner together with its ledger entry. It returns false if the owner or
+// tx does not exist anymore, or if the owner has already stored the same signature.
+func (d *multisigTxDao) AddSigner(id string, signature string, signerAddress string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare(addSignerQuery)
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(signature, true, id, signerAddress)
+
+	var updated int64
+	if err == nil {
+		updated, err = res.RowsAffected()
+	}
+	// the ledger must not contain a signature which has not been stored
+	if err == nil && updated > 0 {
+		err = appendLedgerEntries(tx, []*model.LedgerEntry{signLedgerEntry(model.LedgerTableMultisigTxOwners, id, signerAddress, signature)})
+		if err == nil {
+			err = insertAuditEvent(tx, event)
+		}
+		if err == nil {
+			err = insertOutboxEvent(tx, model.MultisigTxEventSigned, id, signerAddress)
+		}
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// AddSigners stores the signatures of several owners in a single db transaction, either all of them are stored or none.
+// It returns false if an owner or tx does not exist anymore, or if the owner has already stored the same signature.
+func (d *multisigTxDao) AddSigners(signers []model.MultisigTxOwner, events []*model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare(addSignerQuery)
+	if err != nil {
+		return false, err
+	}
+	ledgerEntries := make([]*model.LedgerEntry, 0, len(signers))
+	for i, signer := range signers {
+		res, err := stmt.Exec(signer.Signature, true, signer.MultisigTxId, signer.Address)
+
+		var updated int64
+		if err == nil {
+			updated, err = res.RowsAffected()
+		}
+		if err == nil && updated > 0 {
+			err = insertAuditEvent(tx, events[i])
+			if err == nil {
+				err = insertOutboxEvent(tx, model.MultisigTxEventSigned, signer.MultisigTxId, signer.Address)
+			}
+		}
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+			return false, err
+		}
+		if updated == 0 {
+			if err = tx.Rollback(); err != nil {
+				log.Printf("Rollback failed: %v", err)
+			}
+			return false, nil
+		}
+		ledgerEntries = append(ledgerEntries, signLedgerEntry(model.LedgerTableMultisigTxOwners, signer.MultisigTxId, signer.Address, signer.Signature))
+	}
+
+	err = appendLedgerEntries(tx, ledgerEntries)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddRejection stores the rejection vote of an owner who has neither signed nor rejected the tx yet.
+// It returns false if the vote has not been stored.
+func (d *multisigTxDao) AddRejection(id string, rejection string, ownerAddress string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx_owners SET rejection = ?, rejected_at = ? " +
+		"WHERE multisig_tx_id = ? AND address = ? AND is_signer = FALSE AND rejected_at IS NULL")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(rejection, time.Now().UTC(), id, ownerAddress)
+
+	var updated int64
+	if err == nil {
+		updated, err = res.RowsAffected()
+	}
+	if err == nil && updated > 0 {
+		err = insertAuditEvent(tx, event)
+		if err == nil {
+			err = insertOutboxEvent(tx, model.MultisigTxEventRejected, id, ownerAddress)
+		}
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// AddCancelVote stores the vote of an owner to cancel the tx. It returns false if the owner has already voted.
+func (d *multisigTxDao) AddCancelVote(id string, ownerAddress string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx_owners SET cancel_voted_at = ? " +
+		"WHERE multisig_tx_id = ? AND address = ? AND cancel_voted_at IS NULL")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(time.Now().UTC(), id, ownerAddress)
+
+	var updated int64
+	if err == nil {
+		updated, err = res.RowsAffected()
+	}
+	if err == nil && updated > 0 {
+		err = insertAuditEvent(tx, event)
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// WithdrawSigner removes the signature of the owner and records when it has been withdrawn. Signatures
+// can only be withdrawn as long as the tx has not been issued; false is returned otherwise or if the
+// owner has not signed.
+func (d *multisigTxDao) WithdrawSigner(id string, signerAddress string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx_owners SET signature = NULL, is_signer = FALSE, withdrawn_at = ? " +
+		"WHERE multisig_tx_id = ? AND address = ? AND is_signer = TRUE " +
+		"AND multisig_tx_id IN (SELECT id FROM multisig_tx WHERE state IN ('pending', 'threshold_reached'))")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(time.Now().UTC(), id, signerAddress)
+	var updated int64
+	if err == nil {
+		updated, err = res.RowsAffected()
+	}
+	if err == nil && updated > 0 {
+		err = appendLedgerEntries(tx, []*model.LedgerEntry{{
+			Operation: model.LedgerOperationWithdraw,
+			Table:     model.LedgerTableMultisigTxOwners,
+			RecordId:  id,
+			Address:   signerAddress,
+		}})
+		if err == nil {
+			err = insertAuditEvent(tx, event)
+		}
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+func (d *multisigTxDao) UpdateState(id string, from model.MultisigTxState, to model.MultisigTxState) (bool, error) {
+	return d.UpdateStateWithReason(id, from, to, "")
+}
+
+// UpdateStateWithReason updates the state like UpdateState and records why the state has been changed
+func (d *multisigTxDao) UpdateStateWithReason(id string, from model.MultisigTxState, to model.MultisigTxState, reason string) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx SET state = ?, state_updated_at = ?, state_reason = ? WHERE id = ? AND state = ?")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(to, time.Now().UTC(), sql.NullString{String: reason, Valid: reason != ""}, id, from)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	updated, err := res.RowsAffected()
+	if eventType, ok := stateEvents[to]; err == nil && ok && updated > 0 {
+		err = insertOutboxEvent(tx, eventType, id, "")
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// CancelTx moves the tx from the given state to cancelled and records who has cancelled it and why.
+// It returns false if the stored state has been changed concurrently.
+func (d *multisigTxDao) CancelTx(id string, from model.MultisigTxState, cancelledBy string, reason string, event *model.AuditEvent) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	stmt, err := tx.Prepare("UPDATE multisig_tx SET state = ?, state_updated_at = ?, state_reason = ?, cancelled_by = ? WHERE id = ? AND state = ?")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(model.MultisigTxStateCancelled, time.Now().UTC(), sql.NullString{String: reason, Valid: reason != ""}, cancelledBy, id, from)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	updated, err := res.RowsAffected()
+	if err == nil && updated > 0 {
+		err = insertAuditEvent(tx, event)
+		if err == nil {
+			err = insertOutboxEvent(tx, model.MultisigTxEventCancelled, id, cancelledBy)
+		}
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// ArchiveTx moves a tx together with its owners and owner tree to the archive tables, keeping its id,
+// so that an identical tx can be created again
+func (d *multisigTxDao) ArchiveTx(id string, event *model.AuditEvent) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO multisig_tx_archive (id, unsigned_tx, alias, threshold, chain_id, transaction_id, parent_transaction, output_owners, metadata, auto_issue, state, state_updated_at, state_reason, creator, cancelled_by, issuer, issued_at, tx_status, tx_status_reason, tx_status_updated_at, expires_at, extended_by, extended_at, created_at, archived_at) " +
+		"SELECT id, unsigned_tx, alias, threshold, chain_id, transaction_id, parent_transaction, output_owners, metadata, auto_issue, state, state_updated_at, state_reason, creator, cancelled_by, issuer, issued_at, tx_status, tx_status_reason, tx_status_updated_at, expires_at, extended_by, extended_at, created_at, ? " +
+		"FROM multisig_tx WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	res, err := stmt.Exec(time.Now().UTC(), id)
+
+	var archiveId int64
+	if err == nil {
+		archiveId, err = res.LastInsertId()
+	}
+	if err == nil {
+		stmt, err = tx.Prepare("INSERT INTO multisig_tx_owners_archive (archive_id, address, signature, is_signer, withdrawn_at, rejection, rejected_at, cancel_voted_at, created_at) " +
+			"SELECT ?, address, signature, is_signer, withdrawn_at, rejection, rejected_at, cancel_voted_at, created_at FROM multisig_tx_owners WHERE multisig_tx_id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(archiveId, id)
+	}
+	if err == nil {
+		stmt, err = tx.Prepare("INSERT INTO multisig_tx_owner_tree_archive (archive_id, node_index, parent_index, address, threshold) " +
+			"SELECT ?, node_index, parent_index, address, threshold FROM multisig_tx_owner_tree WHERE multisig_tx_id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(archiveId, id)
+	}
+	if err == nil {
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx_owner_tree WHERE multisig_tx_id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(id)
+	}
+	if err == nil {
+		// the inputs are only needed for the conflict detection of active txs
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx_inputs WHERE multisig_tx_id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(id)
+	}
+	if err == nil {
+		// delete owners first
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx_owners WHERE multisig_tx_id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(id)
+	}
+	if err == nil {
+		// the signatures have been moved to the archive, which is not covered by the ledger
+		err = appendLedgerEntries(tx, []*model.LedgerEntry{{
+			Operation: model.LedgerOperationDelete,
+			Table:     model.LedgerTableMultisigTxOwners,
+			RecordId:  id,
+		}})
+	}
+	if err == nil {
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(id)
+	}
+	if err == nil {
+		err = insertAuditEvent(tx, event)
+	}
+
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return err
+	}
+
 	return nil
 }
+
+// PurgeArchivedTx deletes the archived txs together with their owners and owner tree which have been archived
+// before the given time. It returns the number of deleted txs.
+func (d *multisigTxDao) PurgeArchivedTx(archivedBefore time.Time) (int64, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM multisig_tx_owner_tree_archive WHERE archive_id IN " +
+		"(SELECT archive_id FROM multisig_tx_archive WHERE archived_at < ?)")
+	if err != nil {
+		return 0, err
+	}
+	_, err = stmt.Exec(archivedBefore)
+	if err == nil {
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx_owners_archive WHERE archive_id IN " +
+			"(SELECT archive_id FROM multisig_tx_archive WHERE archived_at < ?)")
+		if err != nil {
+			return 0, err
+		}
+		_, err = stmt.Exec(archivedBefore)
+	}
+	var purged int64
+	if err == nil {
+		stmt, err = tx.Prepare("DELETE FROM multisig_tx_archive WHERE archived_at < ?")
+		if err != nil {
+			return 0, err
+		}
+		var res sql.Result
+		res, err = stmt.Exec(archivedBefore)
+		if err == nil {
+			purged, err = res.RowsAffected()
+		}
+	}
+
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -99,6 +100,19 @@ func TestNewMultisigTxDao(t *testing.T) {
 	}
 }
 
+func auditEvent(action model.AuditAction, id string, alias string) *model.AuditEvent {
+	return &model.AuditEvent{
+		Action:       action,
+		MultisigTxId: id,
+		Alias:        alias,
+		Actor:        "address1",
+		Signature:    "signature",
+		RequestId:    "request_id",
+		ClientIp:     "127.0.0.1",
+		PayloadHash:  "payload_hash",
+	}
+}
+
 func TestCreateMultisigTx(t *testing.T) {
 	type fields struct {
 		db *db.Db
@@ -128,7 +142,10 @@ func TestCreateMultisigTx(t *testing.T) {
 					ChainId:      "11111111111111111111111111111111LpoYY",
 					OutputOwners: "OutputOwners",
 					Metadata:     "metadata",
+					AutoIssue:    true,
+					State:        model.MultisigTxStatePending,
 					Expiration:   &exp,
+					InputIds:     []string{"utxo_new", "utxo_shared"},
 					Owners: []model.MultisigTxOwner{
 						{
 							Address:   "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
@@ -179,7 +196,7 @@ func TestCreateMultisigTx(t *testing.T) {
 			d := &multisigTxDao{
 				db: tt.fields.db,
 			}
-			got, err := d.CreateMultisigTx(tt.args.multisigTx)
+			got, err := d.CreateMultisigTx(tt.args.multisigTx, auditEvent(model.AuditActionCreate, tt.args.multisigTx.Id, tt.args.multisigTx.Alias))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateMultisigTx() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -207,6 +224,18 @@ func TestAddSigner(t *testing.T) {
 		want    bool
 		wantErr bool
 	}{
+		{
+			name: "Add signer without signature",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:            "bc6246f58b5aba675f4071bd1a13d7a774384e42f301208d1c2b0f22ee602e69",
+				signerAddress: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			},
+			want:    true,
+			wantErr: false,
+		},
 		{
 			name: "Add signer with signature",
 			fields: fields{
@@ -221,15 +250,29 @@ func TestAddSigner(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Add signer without signature",
+			name: "Add signer with the stored signature",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
 				id:            "bc6246f58b5aba675f4071bd1a13d7a774384e42f301208d1c2b0f22ee602e69",
+				signature:     "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
 				signerAddress: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
 			},
-			want:    true,
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "Add signer to non existing tx",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:            "non_existing_id",
+				signature:     "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
+				signerAddress: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			},
+			want:    false,
 			wantErr: false,
 		},
 	}
@@ -238,7 +281,7 @@ func TestAddSigner(t *testing.T) {
 			d := &multisigTxDao{
 				db: tt.fields.db,
 			}
-			got, err := d.AddSigner(tt.args.id, tt.args.signature, tt.args.signerAddress)
+			got, err := d.AddSigner(tt.args.id, tt.args.signature, tt.args.signerAddress, auditEvent(model.AuditActionSign, tt.args.id, "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AddSigner() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -248,6 +291,56 @@ func TestAddSigner(t *testing.T) {
 			}
 		})
 	}
+
+	// a signature which has not been stored is not appended to the ledger
+	var ledgerEntries int
+	err := conn.QueryRow("SELECT count(*) FROM signature_ledger WHERE record_id = ?", "non_existing_id").Scan(&ledgerEntries)
+	assert.NoError(t, err)
+	assert.Zero(t, ledgerEntries)
+}
+
+func TestAddSigners(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+	signers := []model.MultisigTxOwner{
+		{
+			MultisigTxId: "bc6246f58b5aba675f4071bd1a13d7a774384e42f301208d1c2b0f22ee602e69",
+			Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			Signature:    "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc001",
+		},
+		{
+			MultisigTxId: "bc6246f58b5aba675f4071bd1a13d7a774384e42f301208d1c2b0f22ee602e69",
+			Address:      "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+			Signature:    "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc001",
+		},
+	}
+
+	events := []*model.AuditEvent{
+		auditEvent(model.AuditActionSign, signers[0].MultisigTxId, "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"),
+		auditEvent(model.AuditActionSign, signers[1].MultisigTxId, "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"),
+	}
+
+	// none of the signatures is stored if an owner does not exist
+	unknownOwner := model.MultisigTxOwner{MultisigTxId: "non_existing_id", Address: "address1", Signature: "signature"}
+	got, err := d.AddSigners([]model.MultisigTxOwner{signers[0], unknownOwner}, events)
+	assert.NoError(t, err)
+	assert.False(t, got)
+	stored, err := d.GetMultisigTx(signers[0].MultisigTxId, "", "", false)
+	if !assert.NoError(t, err) || !assert.NotNil(t, stored) {
+		return
+	}
+	for _, owner := range (*stored)[0].Owners {
+		assert.NotEqual(t, signers[0].Signature, owner.Signature)
+	}
+
+	got, err = d.AddSigners(signers, events)
+	assert.NoError(t, err)
+	assert.True(t, got)
+
+	got, err = d.AddSigners([]model.MultisigTxOwner{}, []*model.AuditEvent{})
+	assert.NoError(t, err)
+	assert.True(t, got)
 }
 
 func TestGetMultisigTx(t *testing.T) {
@@ -282,6 +375,7 @@ func TestGetMultisigTx(t *testing.T) {
 					UnsignedTx:   "unsigned_tx",
 					OutputOwners: "output_owners",
 					Metadata:     "metadata",
+					State:        model.MultisigTxStatePending,
 					Owners: []model.MultisigTxOwner{
 						{
 							MultisigTxId: "1",
@@ -329,85 +423,142 @@ func TestGetMultisigTx(t *testing.T) {
 			assert.Equal(t, (*got)[0].TransactionId, (*tt.want)[0].TransactionId)
 			assert.Equal(t, (*got)[0].OutputOwners, (*tt.want)[0].OutputOwners)
 			assert.Equal(t, (*got)[0].Metadata, (*tt.want)[0].Metadata)
+			assert.Equal(t, (*got)[0].State, (*tt.want)[0].State)
 			assert.Equal(t, (*got)[0].Owners, (*tt.want)[0].Owners)
+			assert.Equal(t, (*got)[0].OwnerTree, (*tt.want)[0].OwnerTree)
 			assert.NotEmpty(t, (*got)[0].Timestamp)
 		})
 	}
 }
 
-func TestPendingAliasExists(t *testing.T) {
+func TestGetMultisigTxForOwner(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	// settled txs stay listed after their expiration
+	got, err := d.GetMultisigTx("", "alias_17", "address17", false)
+	assert.NoError(t, err)
+	if !assert.Len(t, *got, 1) {
+		return
+	}
+	assert.Equal(t, "17", (*got)[0].Id)
+	assert.Equal(t, model.MultisigTxStateCommitted, (*got)[0].State)
+
+	// expired pending txs are not
+	got, err = d.GetMultisigTx("", "alias_12", "address1", false)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetMultisigTxWithOwnerTree(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+	exp := time.Now().Add(time.Hour * 24 * 7)
+	ownerTree := []model.OwnerNode{
+		{Address: "address_a"},
+		{
+			Address:   "nested_alias",
+			Threshold: 1,
+			Owners:    []model.OwnerNode{{Address: "address_b"}, {Address: "address_c"}},
+		},
+	}
+	_, err := d.CreateMultisigTx(&model.MultisigTx{
+		Id:           "owner_tree",
+		UnsignedTx:   "unsigned_tx_owner_tree",
+		Alias:        "alias_owner_tree",
+		Threshold:    2,
+		ChainId:      "11111111111111111111111111111111LpoYY",
+		OutputOwners: "output_owners",
+		State:        model.MultisigTxStatePending,
+		Expiration:   &exp,
+		Owners: []model.MultisigTxOwner{
+			{Address: "address_a"},
+			{Address: "address_b"},
+			{Address: "address_c"},
+		},
+		OwnerTree: ownerTree,
+	}, auditEvent(model.AuditActionCreate, "owner_tree", "alias_owner_tree"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	got, err := d.GetMultisigTx("owner_tree", "", "", true)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	assert.Len(t, (*got)[0].Owners, 3)
+	assert.Equal(t, ownerTree, (*got)[0].OwnerTree)
+}
+
+func TestGetConflictingTxIds(t *testing.T) {
 	type fields struct {
 		db *db.Db
 	}
 	type args struct {
-		alias   string
-		chainId string
+		utxoIds []string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    bool
+		want    []string
 		wantErr bool
 	}{
 		{
-			name: "Test pending tx for existing msig alias",
+			name: "Test utxo spent by a pending tx",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
-				alias:   "alias_3",
-				chainId: "11111111111111111111111111111111LpoYY",
+				utxoIds: []string{"utxo_1"},
 			},
-			want:    true,
+			want:    []string{"1"},
 			wantErr: false,
 		},
 		{
-			name: "Test pending tx for existing msig alias on different chain",
+			name: "Test utxos spent by pending and issued txs",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
-				alias:   "alias_3",
-				chainId: "jvYyfQTxGMJLuGWa55kdP2p2zSUYsQ5Raupu4TW34ZAUBAbtq",
+				utxoIds: []string{"utxo_shared", "utxo_2"},
 			},
-			want:    true,
+			want:    []string{"1", "2", "bc6246f58b5aba675f4071bd1a13d7a774384e42f301208d1c2b0f22ee602e69"},
 			wantErr: false,
 		},
 		{
-			name: "Test complete tx for existing msig alias on different chain",
+			name: "Test utxo spent by a cancelled tx",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
-				alias:   "alias_6",
-				chainId: "jvYyfQTxGMJLuGWa55kdP2p2zSUYsQ5Raupu4TW34ZAUBAbtq",
+				utxoIds: []string{"utxo_7"},
 			},
-			want:    false,
+			want:    []string{},
 			wantErr: false,
 		},
 		{
-			name: "Test pending tx for existing complete msig alias",
+			name: "Test unknown utxo",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
-				alias:   "alias_2",
-				chainId: "11111111111111111111111111111111LpoYY",
+				utxoIds: []string{"unknown"},
 			},
-			want:    false,
+			want:    []string{},
 			wantErr: false,
 		},
 		{
-			name: "Test pending tx for non existing msig alias",
+			name: "Test no utxos",
 			fields: fields{
 				db: &db.Db{DB: conn},
 			},
 			args: args{
-				alias:   "test",
-				chainId: "11111111111111111111111111111111LpoYY",
+				utxoIds: []string{},
 			},
-			want:    false,
+			want:    []string{},
 			wantErr: false,
 		},
 	}
@@ -416,18 +567,51 @@ func TestPendingAliasExists(t *testing.T) {
 			d := &multisigTxDao{
 				db: tt.fields.db,
 			}
-			got, err := d.PendingAliasExists(tt.args.alias, tt.args.chainId)
+			got, err := d.GetConflictingTxIds(tt.args.utxoIds)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("PendingAliasExists() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetConflictingTxIds() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
-				t.Errorf("PendingAliasExists() got = %v, want %v", got, tt.want)
-			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestGetChildTxIds(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	got, err := d.GetChildTxIds([]string{"2", "transaction_id_2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"14"}, got)
+
+	got, err = d.GetChildTxIds([]string{"1"})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestGetPendingTxForOwner(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	got, err := d.GetPendingTxForOwner("inbox_address")
+	assert.NoError(t, err)
+	assert.Len(t, *got, 1)
+	tx := (*got)[0]
+	assert.Equal(t, "15", tx.Id) // 16 has already been signed by the owner
+	assert.Equal(t, "alias_15", tx.Alias)
+	assert.Equal(t, model.MultisigTxStatePending, tx.State)
+	assert.NotNil(t, tx.Expiration)
+	// all owners of the tx are returned, not only the one it has been filtered by
+	assert.Len(t, tx.Owners, 2)
+
+	got, err = d.GetPendingTxForOwner("unknown_address")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
 func TestUpdateTransactionId(t *testing.T) {
 	type fields struct {
 		db *db.Db
@@ -455,13 +639,25 @@ func TestUpdateTransactionId(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Update transaction id for issued multisig tx",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:            "3",
+				transactionId: "transaction_id_3_2",
+			},
+			want:    false,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			d := &multisigTxDao{
 				db: tt.fields.db,
 			}
-			got, err := d.UpdateTransactionId(tt.args.id, tt.args.transactionId)
+			got, err := d.UpdateTransactionId(tt.args.id, tt.args.transactionId, "address1", auditEvent(model.AuditActionIssue, tt.args.id, "alias"))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UpdateTransactionId() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -471,4 +667,724 @@ func TestUpdateTransactionId(t *testing.T) {
 			}
 		})
 	}
+
+	// the second issuance has not happened and is not audited
+	var issueEvents int
+	err := conn.QueryRow("SELECT count(*) FROM audit_events WHERE multisig_tx_id = ? AND action = ?", "3", model.AuditActionIssue).Scan(&issueEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, issueEvents)
+}
+
+func TestGetActiveTx(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	got, err := d.GetActiveTx()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, *got)
+	for _, tx := range *got {
+		assert.Contains(t, []model.MultisigTxState{model.MultisigTxStatePending, model.MultisigTxStateThresholdReached}, tx.State)
+		assert.NotEmpty(t, tx.UnsignedTx)
+		assert.NotEmpty(t, tx.Alias)
+	}
+	ids := make([]string, 0, len(*got))
+	for _, tx := range *got {
+		ids = append(ids, tx.Id)
+	}
+	assert.Contains(t, ids, "1")
+	assert.NotContains(t, ids, "2") // issued
+	assert.NotContains(t, ids, "7") // cancelled
+}
+
+func TestGetExpiredTx(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	got, err := d.GetExpiredTx()
+	assert.NoError(t, err)
+	ids := make([]string, 0, len(*got))
+	for _, tx := range *got {
+		assert.Contains(t, []model.MultisigTxState{model.MultisigTxStatePending, model.MultisigTxStateThresholdReached}, tx.State)
+		assert.NotNil(t, tx.Expiration)
+		ids = append(ids, tx.Id)
+	}
+	assert.Contains(t, ids, "12")
+	assert.NotContains(t, ids, "1") // not expired yet
+}
+
+func TestUpdateTxStatus(t *testing.T) {
+	type fields struct {
+		db *db.Db
+	}
+	type args struct {
+		id       string
+		txStatus string
+		reason   string
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		want          bool
+		wantErr       bool
+		wantUnsettled bool
+	}{
+		{
+			name: "Update status of issued multisig tx to processing",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:       "2",
+				txStatus: "Processing",
+			},
+			want:          true,
+			wantErr:       false,
+			wantUnsettled: true,
+		},
+		{
+			name: "Update status of issued multisig tx to dropped",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:       "6",
+				txStatus: "Dropped",
+				reason:   "failed verification",
+			},
+			want:          true,
+			wantErr:       false,
+			wantUnsettled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &multisigTxDao{
+				db: tt.fields.db,
+			}
+			got, err := d.UpdateTxStatus(tt.args.id, tt.args.txStatus, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateTxStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UpdateTxStatus() got = %v, want %v", got, tt.want)
+			}
+
+			unsettled, err := d.GetUnsettledIssuedTx()
+			assert.NoError(t, err)
+			found := false
+			for _, tx := range *unsettled {
+				if tx.Id == tt.args.id {
+					found = true
+					assert.Equal(t, tt.args.txStatus, tx.TxStatus)
+					assert.NotEmpty(t, tx.Alias)
+				}
+			}
+			assert.Equal(t, tt.wantUnsettled, found)
+		})
+	}
+}
+
+func TestUpdateState(t *testing.T) {
+	type fields struct {
+		db *db.Db
+	}
+	type args struct {
+		id   string
+		from model.MultisigTxState
+		to   model.MultisigTxState
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "Update state of issued multisig tx",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:   "6",
+				from: model.MultisigTxStateIssued,
+				to:   model.MultisigTxStateRejected,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Update state with outdated current state",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				id:   "6",
+				from: model.MultisigTxStateIssued,
+				to:   model.MultisigTxStateCommitted,
+			},
+			want:    false,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &multisigTxDao{
+				db: tt.fields.db,
+			}
+			got, err := d.UpdateState(tt.args.id, tt.args.from, tt.args.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateState() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UpdateState() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithdrawSigner(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	tests := []struct {
+		name          string
+		id            string
+		signerAddress string
+		want          bool
+	}{
+		{
+			name:          "Withdraw signature",
+			id:            "9",
+			signerAddress: "address1",
+			want:          true,
+		},
+		{
+			name:          "Withdraw signature of owner who has not signed",
+			id:            "9",
+			signerAddress: "address2",
+			want:          false,
+		},
+		{
+			name:          "Withdraw signature of issued tx",
+			id:            "2",
+			signerAddress: "address1",
+			want:          false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.WithdrawSigner(tt.id, tt.signerAddress, auditEvent(model.AuditActionWithdraw, tt.id, "alias_"+tt.id))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := d.GetMultisigTx("9", "", "", false)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	for _, owner := range (*got)[0].Owners {
+		assert.Empty(t, owner.Signature)
+		if owner.Address == "address1" {
+			assert.NotNil(t, owner.WithdrawnAt)
+		} else {
+			assert.Nil(t, owner.WithdrawnAt)
+		}
+	}
+}
+
+func TestAddRejection(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	tests := []struct {
+		name         string
+		id           string
+		ownerAddress string
+		want         bool
+	}{
+		{
+			name:         "Add rejection",
+			id:           "9",
+			ownerAddress: "address2",
+			want:         true,
+		},
+		{
+			name:         "Add rejection of owner who has already rejected",
+			id:           "9",
+			ownerAddress: "address2",
+			want:         false,
+		},
+		{
+			name:         "Add rejection of owner who has signed",
+			id:           "3",
+			ownerAddress: "address1",
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.AddRejection(tt.id, "rejection", tt.ownerAddress, auditEvent(model.AuditActionReject, tt.id, "alias_"+tt.id))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := d.GetMultisigTx("9", "", "", false)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	for _, owner := range (*got)[0].Owners {
+		if owner.Address == "address2" {
+			assert.Equal(t, "rejection", owner.Rejection)
+			assert.NotNil(t, owner.RejectedAt)
+		}
+	}
+
+	// only the stored rejection is audited
+	var rejectEvents int
+	err = conn.QueryRow("SELECT count(*) FROM audit_events WHERE multisig_tx_id = ? AND action = ?", "9", model.AuditActionReject).Scan(&rejectEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rejectEvents)
+}
+
+func TestUpdateStateWithReason(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	updated, err := d.UpdateStateWithReason("8", model.MultisigTxStatePending, model.MultisigTxStateInvalidated, "threshold changed from 2 to 3")
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	got, err := d.GetMultisigTx("8", "", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, model.MultisigTxStateInvalidated, (*got)[0].State)
+	assert.Equal(t, "threshold changed from 2 to 3", (*got)[0].StateReason)
+	assert.NotNil(t, (*got)[0].StateUpdatedAt)
+}
+
+func TestAddCancelVote(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	tests := []struct {
+		name         string
+		ownerAddress string
+		want         bool
+	}{
+		{
+			name:         "Add cancel vote",
+			ownerAddress: "address2",
+			want:         true,
+		},
+		{
+			name:         "Add cancel vote of owner who has already voted",
+			ownerAddress: "address2",
+			want:         false,
+		},
+		{
+			name:         "Add cancel vote of non owner",
+			ownerAddress: "address3",
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.AddCancelVote("10", tt.ownerAddress, auditEvent(model.AuditActionCancelVote, "10", "alias_10"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got, err := d.GetMultisigTx("10", "", "", false)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	for _, owner := range (*got)[0].Owners {
+		if owner.Address == "address2" {
+			assert.NotNil(t, owner.CancelVotedAt)
+		} else {
+			assert.Nil(t, owner.CancelVotedAt)
+		}
+	}
+
+	var voteEvents int
+	err = conn.QueryRow("SELECT count(*) FROM audit_events WHERE multisig_tx_id = ? AND action = ?", "10", model.AuditActionCancelVote).Scan(&voteEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, voteEvents)
+}
+
+func TestCancelTx(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	updated, err := d.CancelTx("10", model.MultisigTxStateThresholdReached, "address1", "wrong amount", auditEvent(model.AuditActionCancel, "10", "alias_10"))
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	updated, err = d.CancelTx("10", model.MultisigTxStatePending, "address1", "wrong amount", auditEvent(model.AuditActionCancel, "10", "alias_10"))
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	got, err := d.GetMultisigTx("10", "", "", false)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	assert.Equal(t, model.MultisigTxStateCancelled, (*got)[0].State)
+	assert.Equal(t, "address1", (*got)[0].CancelledBy)
+	assert.Equal(t, "wrong amount", (*got)[0].StateReason)
+	assert.Equal(t, "address1", (*got)[0].Creator)
+
+	// only the successful cancellation is audited
+	var cancelEvents int
+	err = conn.QueryRow("SELECT count(*) FROM audit_events WHERE multisig_tx_id = ? AND action = ?", "10", model.AuditActionCancel).Scan(&cancelEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cancelEvents)
+}
+
+func TestUpdateExpirationDate(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+	expiration := time.Now().UTC().Add(time.Hour * 24 * 30).Truncate(time.Second)
+
+	updated, err := d.UpdateExpirationDate("2", expiration, "address1", auditEvent(model.AuditActionExtend, "2", "alias_2"))
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	updated, err = d.UpdateExpirationDate("11", expiration, "address1", auditEvent(model.AuditActionExtend, "11", "alias_11"))
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	got, err := d.GetMultisigTx("11", "", "", true)
+	if !assert.NoError(t, err) || !assert.NotNil(t, got) {
+		return
+	}
+	assert.True(t, expiration.Equal(*(*got)[0].Expiration))
+	assert.Equal(t, "address1", (*got)[0].ExtendedBy)
+	assert.NotNil(t, (*got)[0].ExtendedAt)
+
+	// only the applied extension is audited
+	var extendEvents int
+	err = conn.QueryRow("SELECT count(*) FROM audit_events WHERE multisig_tx_id IN (?, ?) AND action = ?", "2", "11", model.AuditActionExtend).Scan(&extendEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, extendEvents)
+}
+
+func TestArchiveTx(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	err := d.ArchiveTx("7", auditEvent(model.AuditActionArchive, "7", "alias_7"))
+	assert.NoError(t, err)
+
+	got, err := d.GetMultisigTx("7", "", "", false)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	var (
+		archivedState  string
+		archivedOwners int
+	)
+	err = conn.QueryRow("SELECT state FROM multisig_tx_archive WHERE id = ?", "7").Scan(&archivedState)
+	assert.NoError(t, err)
+	assert.Equal(t, string(model.MultisigTxStateCancelled), archivedState)
+	err = conn.QueryRow("SELECT count(*) FROM multisig_tx_owners_archive AS owners "+
+		"JOIN multisig_tx_archive AS tx ON tx.archive_id = owners.archive_id WHERE tx.id = ?", "7").Scan(&archivedOwners)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, archivedOwners)
+
+	history, total, err := d.GetMultisigTxHistory("alias_7", "address1", 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, "7", (*history)[0].Id)
+	assert.Equal(t, model.MultisigTxStateCancelled, (*history)[0].State)
+	assert.NotNil(t, (*history)[0].ArchivedAt)
+	assert.Len(t, (*history)[0].Owners, 1)
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	err := d.ArchiveTx("6", auditEvent(model.AuditActionArchive, "6", "alias_6"))
+	assert.NoError(t, err)
+
+	got, total, err := d.GetAuditEvents("alias_6", 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	if !assert.Len(t, *got, 1) {
+		return
+	}
+	event := (*got)[0]
+	assert.Equal(t, model.AuditActionArchive, event.Action)
+	assert.Equal(t, "6", event.MultisigTxId)
+	assert.Equal(t, "address1", event.Actor)
+	assert.Equal(t, "signature", event.Signature)
+	assert.Equal(t, "request_id", event.RequestId)
+	assert.Equal(t, "127.0.0.1", event.ClientIp)
+	assert.Equal(t, "payload_hash", event.PayloadHash)
+	assert.NotNil(t, event.Timestamp)
+
+	got, total, err = d.GetAuditEvents("alias_6", 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Empty(t, *got)
+
+	// the audit log is append-only
+	_, err = conn.Exec("DELETE FROM audit_events WHERE alias = ?", "alias_6")
+	assert.Error(t, err)
+}
+
+func TestSignatureLedger(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	head, err := d.GetLedgerHead()
+	assert.NoError(t, err)
+
+	_, err = d.AddSigner("14", "signature14", "address1", auditEvent(model.AuditActionSign, "14", "alias_14"))
+	assert.NoError(t, err)
+	withdrawn, err := d.WithdrawSigner("14", "address1", auditEvent(model.AuditActionWithdraw, "14", "alias_14"))
+	assert.NoError(t, err)
+	assert.True(t, withdrawn)
+
+	got, err := d.GetLedgerProofEntries("14")
+	assert.NoError(t, err)
+	if !assert.Len(t, *got, 2) {
+		return
+	}
+	sign, withdraw := (*got)[0], (*got)[1]
+	assert.Equal(t, model.LedgerOperationSign, sign.Operation)
+	assert.Equal(t, model.LedgerTableMultisigTxOwners, sign.Table)
+	assert.Equal(t, "address1", sign.Address)
+	assert.Equal(t, "signature14", sign.Signature)
+	assert.Equal(t, head, sign.PrevHash)
+	assert.Equal(t, model.LedgerOperationWithdraw, withdraw.Operation)
+	assert.Empty(t, withdraw.Signature)
+	assert.Equal(t, sign.Hash, withdraw.PrevHash)
+	hash, err := withdraw.ComputeHash()
+	assert.NoError(t, err)
+	assert.Equal(t, withdraw.Hash, hash)
+
+	head, err = d.GetLedgerHead()
+	assert.NoError(t, err)
+	assert.Equal(t, withdraw.Hash, head)
+
+	entries, err := d.GetLedgerEntries(sign.Id, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.LedgerEntry{withdraw}, *entries)
+
+	stored, err := d.GetStoredSignatures()
+	assert.NoError(t, err)
+	assert.Contains(t, *stored, model.LedgerEntry{
+		Operation: model.LedgerOperationSign,
+		Table:     model.LedgerTableMultisigTxOwners,
+		RecordId:  "2",
+		Address:   "address1",
+		Signature: "signature1",
+	})
+
+	// the ledger is append-only
+	_, err = conn.Exec("UPDATE signature_ledger SET signature = ? WHERE id = ?", "tampered", sign.Id)
+	assert.Error(t, err)
+}
+
+func TestGetMultisigTxHistory(t *testing.T) {
+	type fields struct {
+		db *db.Db
+	}
+	type args struct {
+		alias  string
+		owner  string
+		limit  int
+		offset int
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		wantIds   []string
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "Get history of alias with issued tx",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				alias: "alias_2",
+				owner: "address2",
+				limit: 10,
+			},
+			wantIds:   []string{"2"},
+			wantTotal: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Get history of alias for non owner",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				alias: "alias_2",
+				owner: "address",
+				limit: 10,
+			},
+			wantIds:   []string{},
+			wantTotal: 0,
+			wantErr:   false,
+		},
+		{
+			name: "Get history of alias with offset beyond total",
+			fields: fields{
+				db: &db.Db{DB: conn},
+			},
+			args: args{
+				alias:  "alias_2",
+				owner:  "address2",
+				limit:  10,
+				offset: 1,
+			},
+			wantIds:   []string{},
+			wantTotal: 1,
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &multisigTxDao{
+				db: tt.fields.db,
+			}
+			got, total, err := d.GetMultisigTxHistory(tt.args.alias, tt.args.owner, tt.args.limit, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMultisigTxHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			ids := make([]string, 0)
+			for _, tx := range *got {
+				ids = append(ids, tx.Id)
+				assert.NotEmpty(t, tx.Owners)
+			}
+			assert.Equal(t, tt.wantIds, ids)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestPurgeArchivedTx(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+
+	purged, err := d.PurgeArchivedTx(time.Now().UTC().Add(-time.Hour * 24 * 30))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	var archived int
+	err = conn.QueryRow("SELECT count(*) FROM multisig_tx_archive WHERE id = ?", "13").Scan(&archived)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, archived)
+	// txs archived within the retention period are kept
+	err = conn.QueryRow("SELECT count(*) FROM multisig_tx_archive").Scan(&archived)
+	assert.NoError(t, err)
+	assert.NotZero(t, archived)
+}
+
+func TestOutbox(t *testing.T) {
+	d := &multisigTxDao{
+		db: &db.Db{DB: conn},
+	}
+	eventsOf := func(id string) []model.OutboxEvent {
+		events, err := d.GetPendingOutboxEvents(1000)
+		assert.NoError(t, err)
+		result := make([]model.OutboxEvent, 0)
+		for _, event := range *events {
+			if event.Event.TxId == id {
+				result = append(result, event)
+			}
+		}
+		return result
+	}
+
+	// a transition which has not been applied doesn't cause an event
+	updated, err := d.UpdateState("11", model.MultisigTxStateThresholdReached, model.MultisigTxStatePending)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	assert.Empty(t, eventsOf("11"))
+
+	updated, err = d.UpdateState("11", model.MultisigTxStatePending, model.MultisigTxStateThresholdReached)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	events := eventsOf("11")
+	if !assert.Len(t, events, 1) {
+		return
+	}
+	event := events[0]
+	assert.Equal(t, model.MultisigTxEventThresholdReached, event.Event.Type)
+	assert.Equal(t, "alias_11", event.Event.Alias)
+	assert.Equal(t, model.MultisigTxStateThresholdReached, event.Event.State)
+	assert.Len(t, event.Event.IdempotencyKey, 2*idempotencyKeySize)
+	assert.Zero(t, event.Attempts)
+
+	err = d.RecordOutboxFailure(event.Id, "sink unavailable")
+	assert.NoError(t, err)
+	events = eventsOf("11")
+	if !assert.Len(t, events, 1) {
+		return
+	}
+	assert.Equal(t, 1, events[0].Attempts)
+	assert.Equal(t, "sink unavailable", events[0].LastError)
+	assert.Equal(t, event.Event, events[0].Event)
+
+	// an error longer than the column is truncated
+	err = d.RecordOutboxFailure(event.Id, "webhook: Post \"https://example.com/"+strings.Repeat("a", 2048)+"\": timeout")
+	assert.NoError(t, err)
+	events = eventsOf("11")
+	if !assert.Len(t, events, 1) {
+		return
+	}
+	assert.Equal(t, 2, events[0].Attempts)
+	assert.Len(t, events[0].LastError, maxLastErrorLength)
+
+	// an event which has failed too often is moved to the dead letters
+	events[0].Attempts++
+	err = d.MoveOutboxEventToDeadLetters(&events[0], "sink unavailable")
+	assert.NoError(t, err)
+	assert.Empty(t, eventsOf("11"))
+	var attempts int
+	var lastError string
+	err = conn.QueryRow("SELECT attempts, last_error FROM outbox_dead_letters WHERE id = ?", event.Id).Scan(&attempts, &lastError)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, "sink unavailable", lastError)
+
+	updated, err = d.UpdateState("11", model.MultisigTxStateThresholdReached, model.MultisigTxStatePending)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	updated, err = d.UpdateState("11", model.MultisigTxStatePending, model.MultisigTxStateThresholdReached)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	events = eventsOf("11")
+	if !assert.Len(t, events, 1) {
+		return
+	}
+	err = d.DeleteOutboxEvent(events[0].Id)
+	assert.NoError(t, err)
+	assert.Empty(t, eventsOf("11"))
 }
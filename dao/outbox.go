@@ -0,0 +1,132 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package dao
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+const idempotencyKeySize = 16
+
+// stateEvents are the lifecycle events caused by moving a tx to the state
+var stateEvents = map[model.MultisigTxState]model.MultisigTxEventType{
+	model.MultisigTxStateThresholdReached: model.MultisigTxEventThresholdReached,
+	model.MultisigTxStateCommitted:        model.MultisigTxEventCommitted,
+	model.MultisigTxStateExpired:          model.MultisigTxEventExpired,
+}
+
+// insertOutboxEvent stores the lifecycle event of the tx within the db transaction of the change it describes, so
+// that the event is relayed if and only if the change has been committed. The alias and the state are read from
+// the tx as changed by the db transaction.
+func insertOutboxEvent(tx *sql.Tx, eventType model.MultisigTxEventType, multisigTxId string, actor string) error {
+	key := make([]byte, idempotencyKeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO outbox (idempotency_key, event_type, multisig_tx_id, alias, state, actor, created_at) " +
+		"SELECT ?, ?, id, alias, state, ?, ? FROM multisig_tx WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(hex.EncodeToString(key), eventType, multisigTxId, nullString(actor), time.Now().UTC(), multisigTxId)
+	return err
+}
+
+// GetPendingOutboxEvents returns the events which have not been relayed yet, in the order they have been stored
+func (d *multisigTxDao) GetPendingOutboxEvents(limit int) (*[]model.OutboxEvent, error) {
+	query := "SELECT id, idempotency_key, event_type, multisig_tx_id, alias, state, actor, attempts, last_error, created_at " +
+		"FROM outbox ORDER BY id LIMIT ?"
+	rows, err := d.db.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.OutboxEvent, 0)
+	for rows.Next() {
+		var (
+			event     model.OutboxEvent
+			actor     sql.NullString
+			lastError sql.NullString
+			createdAt time.Time
+		)
+		err = rows.Scan(&event.Id, &event.Event.IdempotencyKey, &event.Event.Type, &event.Event.TxId, &event.Event.Alias,
+			&event.Event.State, &actor, &event.Attempts, &lastError, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		event.Event.Actor = actor.String
+		event.Event.Timestamp = createdAt.UTC()
+		event.LastError = lastError.String
+		result = append(result, event)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteOutboxEvent removes an event once it has been relayed to all sinks
+func (d *multisigTxDao) DeleteOutboxEvent(id int64) error {
+	_, err := d.db.Exec("DELETE FROM outbox WHERE id = ?", id)
+	return err
+}
+
+// RecordOutboxFailure counts a failed attempt to relay the event, which stays pending
+func (d *multisigTxDao) RecordOutboxFailure(id int64, lastError string) error {
+	_, err := d.db.Exec("UPDATE outbox SET attempts = attempts + 1, last_error = ? WHERE id = ?", nullString(truncateError(lastError)), id)
+	return err
+}
+
+// MoveOutboxEventToDeadLetters replaces an event which has failed too often by a dead letter in a single db
+// transaction, so that it doesn't block the events stored after it
+func (d *multisigTxDao) MoveOutboxEventToDeadLetters(event *model.OutboxEvent, lastError string) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+		}
+	}()
+
+	_, err = tx.Exec("INSERT INTO outbox_dead_letters (id, idempotency_key, event_type, multisig_tx_id, alias, state, actor, attempts, last_error, created_at, failed_at) "+
+		"SELECT id, idempotency_key, event_type, multisig_tx_id, alias, state, actor, ?, ?, created_at, ? FROM outbox WHERE id = ?",
+		event.Attempts, truncateError(lastError), time.Now().UTC(), event.Id)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM outbox WHERE id = ?", event.Id)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
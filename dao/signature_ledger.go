@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package dao
+
+import (
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+const ledgerEntryColumns = "id, operation, table_name, record_id, address, signature, prev_hash, hash, created_at"
+
+// appendLedgerEntries chains the entries to the signature ledger within the db transaction of the signature write.
+// The head of the ledger is locked until the transaction ends, so that concurrent writes are chained one after
+// the other. The hashes of the entries are set.
+func appendLedgerEntries(tx *sql.Tx, entries []*model.LedgerEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var prevHash string
+	err := tx.QueryRow("SELECT hash FROM signature_ledger_head WHERE id = 1 FOR UPDATE").Scan(&prevHash)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO signature_ledger (operation, table_name, record_id, address, signature, prev_hash, hash, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, entry := range entries {
+		entry.PrevHash = prevHash
+		entry.Hash, err = entry.ComputeHash()
+		if err != nil {
+			return err
+		}
+		_, err = stmt.Exec(entry.Operation, entry.Table, entry.RecordId, nullString(entry.Address), nullString(entry.Signature), entry.PrevHash, entry.Hash, now)
+		if err != nil {
+			return err
+		}
+		prevHash = entry.Hash
+	}
+
+	_, err = tx.Exec("UPDATE signature_ledger_head SET hash = ? WHERE id = 1", prevHash)
+	return err
+}
+
+func signLedgerEntry(table string, recordId string, address string, signature string) *model.LedgerEntry {
+	return &model.LedgerEntry{
+		Operation: model.LedgerOperationSign,
+		Table:     table,
+		RecordId:  recordId,
+		Address:   address,
+		Signature: signature,
+	}
+}
+
+// AppendLedgerEntries chains the entries to the signature ledger
+func (d *multisigTxDao) AppendLedgerEntries(entries []*model.LedgerEntry) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = appendLedgerEntries(tx, entries)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+		}
+		log.Print(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetLedgerEntries returns up to limit entries of the signature ledger following the entry with the given id,
+// in the order they have been chained
+func (d *multisigTxDao) GetLedgerEntries(afterId int64, limit int) (*[]model.LedgerEntry, error) {
+	query := "SELECT " + ledgerEntryColumns + " FROM signature_ledger WHERE id > ? ORDER BY id LIMIT ?"
+	return d.getLedgerEntries(query, afterId, limit)
+}
+
+// GetLedgerProofEntries returns the entries of the signature ledger from the first signature of the multisig tx
+// up to the head of the ledger
+func (d *multisigTxDao) GetLedgerProofEntries(id string) (*[]model.LedgerEntry, error) {
+	query := "SELECT " + ledgerEntryColumns + " FROM signature_ledger WHERE id >= " +
+		"(SELECT MIN(id) FROM signature_ledger WHERE table_name = ? AND record_id = ?) ORDER BY id"
+	return d.getLedgerEntries(query, model.LedgerTableMultisigTxOwners, id)
+}
+
+func (d *multisigTxDao) getLedgerEntries(query string, args ...interface{}) (*[]model.LedgerEntry, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.LedgerEntry, 0)
+	for rows.Next() {
+		var (
+			entry     model.LedgerEntry
+			address   sql.NullString
+			signature sql.NullString
+			createdAt time.Time
+		)
+		err = rows.Scan(&entry.Id, &entry.Operation, &entry.Table, &entry.RecordId, &address, &signature, &entry.PrevHash, &entry.Hash, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		t := createdAt.UTC()
+		entry.Address = address.String
+		entry.Signature = signature.String
+		entry.Timestamp = &t
+		result = append(result, entry)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLedgerHead returns the hash of the last entry of the signature ledger
+func (d *multisigTxDao) GetLedgerHead() (string, error) {
+	var head string
+	err := d.db.QueryRow("SELECT hash FROM signature_ledger_head WHERE id = 1").Scan(&head)
+	return head, err
+}
+
+// GetStoredSignatures returns the signatures currently stored for multisig txs and deposit offers as sign entries,
+// so that they can be compared with the state resulting from the ledger
+func (d *multisigTxDao) GetStoredSignatures() (*[]model.LedgerEntry, error) {
+	query := "SELECT '" + model.LedgerTableMultisigTxOwners + "', multisig_tx_id, address, signature FROM multisig_tx_owners " +
+		"WHERE signature IS NOT NULL AND signature <> '' " +
+		"UNION ALL " +
+		"SELECT '" + model.LedgerTableDepositOfferSigs + "', deposit_offer_id, address, signature FROM deposit_offer_sigs " +
+		"WHERE signature IS NOT NULL AND signature <> ''"
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.LedgerEntry, 0)
+	for rows.Next() {
+		var table, recordId, address, signature string
+		err = rows.Scan(&table, &recordId, &address, &signature)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *signLedgerEntry(table, recordId, address, signature))
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,357 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package dao
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/db"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+var _ WebhookDao = (*webhookDao)(nil)
+
+type WebhookDao interface {
+	CreateSubscription(subscription *model.WebhookSubscription) (int64, error)
+	GetSubscriptions() (*[]model.WebhookSubscription, error)
+	GetSubscriptionsForAlias(alias string) (*[]model.WebhookSubscription, error)
+	DeleteSubscription(id int64) (bool, error)
+	EnqueueDeliveries(deliveries []model.WebhookDelivery) error
+	GetDueDeliveries(now time.Time, limit int) (*[]model.WebhookDelivery, error)
+	DeleteDelivery(id int64) error
+	RescheduleDelivery(id int64, attempts int, nextAttemptAt time.Time, lastError string) error
+	MoveToDeadLetters(delivery *model.WebhookDelivery, lastError string) error
+	GetDeadLetters(limit int, offset int) (*[]model.WebhookDeadLetter, int, error)
+	RedeliverDeadLetter(id int64) (bool, error)
+}
+
+type webhookDao struct {
+	db *db.Db
+}
+
+func NewWebhookDao(db *db.Db) WebhookDao {
+	return &webhookDao{
+		db: db,
+	}
+}
+
+const webhookSubscriptionColumns = "id, alias, url, secret, events, created_at"
+
+// maxLastErrorLength is the size of the last_error columns of the deliveries, the dead letters and the outbox
+const maxLastErrorLength = 1024
+
+// truncateError shortens an error to fit into the last_error columns, as errors of the http client contain
+// the url of the request, which can be longer than the column
+func truncateError(lastError string) string {
+	runes := []rune(lastError)
+	if len(runes) <= maxLastErrorLength {
+		return lastError
+	}
+	return string(runes[:maxLastErrorLength])
+}
+
+// CreateSubscription stores the subscription and returns its id
+func (d *webhookDao) CreateSubscription(subscription *model.WebhookSubscription) (int64, error) {
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+	res, err := d.db.Exec("INSERT INTO webhook_subscriptions (alias, url, secret, events, created_at) VALUES (?, ?, ?, ?, ?)",
+		nullString(subscription.Alias), subscription.Url, subscription.Secret, strings.Join(events, ","), time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// GetSubscriptions returns all subscriptions, without their secrets
+func (d *webhookDao) GetSubscriptions() (*[]model.WebhookSubscription, error) {
+	subscriptions, err := d.getSubscriptions("SELECT " + webhookSubscriptionColumns + " FROM webhook_subscriptions ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	for i := range *subscriptions {
+		(*subscriptions)[i].Secret = ""
+	}
+	return subscriptions, nil
+}
+
+// GetSubscriptionsForAlias returns the subscriptions of the alias and the ones of all aliases
+func (d *webhookDao) GetSubscriptionsForAlias(alias string) (*[]model.WebhookSubscription, error) {
+	query := "SELECT " + webhookSubscriptionColumns + " FROM webhook_subscriptions WHERE alias = ? OR alias IS NULL ORDER BY id"
+	return d.getSubscriptions(query, alias)
+}
+
+func (d *webhookDao) getSubscriptions(query string, args ...interface{}) (*[]model.WebhookSubscription, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.WebhookSubscription, 0)
+	for rows.Next() {
+		var (
+			subscription model.WebhookSubscription
+			alias        sql.NullString
+			events       string
+			createdAt    time.Time
+		)
+		err = rows.Scan(&subscription.Id, &alias, &subscription.Url, &subscription.Secret, &events, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		t := createdAt.UTC()
+		subscription.Alias = alias.String
+		subscription.Events = make([]model.MultisigTxEventType, 0)
+		for _, event := range strings.Split(events, ",") {
+			if event != "" {
+				subscription.Events = append(subscription.Events, model.MultisigTxEventType(event))
+			}
+		}
+		subscription.CreatedAt = &t
+		result = append(result, subscription)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSubscription deletes the subscription together with its pending deliveries and dead letters. It returns
+// false if the subscription does not exist.
+func (d *webhookDao) DeleteSubscription(id int64) (bool, error) {
+	res, err := d.db.Exec("DELETE FROM webhook_subscriptions WHERE id = ?", id)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// EnqueueDeliveries stores the deliveries to be attempted at their next attempt time
+func (d *webhookDao) EnqueueDeliveries(deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+		}
+	}()
+
+	stmt, err := tx.Prepare("INSERT INTO webhook_deliveries (subscription_id, event_type, payload, attempts, next_attempt_at) VALUES (?, ?, ?, 0, ?)")
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		_, err = stmt.Exec(delivery.SubscriptionId, delivery.EventType, delivery.Payload, delivery.NextAttemptAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetDueDeliveries returns up to limit deliveries whose next attempt is due, together with the url and secret of
+// their subscription
+func (d *webhookDao) GetDueDeliveries(now time.Time, limit int) (*[]model.WebhookDelivery, error) {
+	query := "SELECT d.id, d.subscription_id, s.url, s.secret, d.event_type, d.payload, d.attempts, d.next_attempt_at, d.last_error " +
+		"FROM webhook_deliveries AS d " +
+		"JOIN webhook_subscriptions AS s ON s.id = d.subscription_id " +
+		"WHERE d.next_attempt_at <= ? ORDER BY d.next_attempt_at, d.id LIMIT ?"
+	rows, err := d.db.Query(query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.WebhookDelivery, 0)
+	for rows.Next() {
+		var (
+			delivery      model.WebhookDelivery
+			nextAttemptAt time.Time
+			lastError     sql.NullString
+		)
+		err = rows.Scan(&delivery.Id, &delivery.SubscriptionId, &delivery.Url, &delivery.Secret, &delivery.EventType,
+			&delivery.Payload, &delivery.Attempts, &nextAttemptAt, &lastError)
+		if err != nil {
+			return nil, err
+		}
+		t := nextAttemptAt.UTC()
+		delivery.NextAttemptAt = &t
+		delivery.LastError = lastError.String
+		result = append(result, delivery)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteDelivery removes a delivery which has been delivered
+func (d *webhookDao) DeleteDelivery(id int64) error {
+	_, err := d.db.Exec("DELETE FROM webhook_deliveries WHERE id = ?", id)
+	return err
+}
+
+// RescheduleDelivery records a failed attempt of the delivery and when it is attempted next
+func (d *webhookDao) RescheduleDelivery(id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	_, err := d.db.Exec("UPDATE webhook_deliveries SET attempts = ?, next_attempt_at = ?, last_error = ? WHERE id = ?",
+		attempts, nextAttemptAt, nullString(truncateError(lastError)), id)
+	return err
+}
+
+// MoveToDeadLetters replaces the delivery by a dead letter in a single db transaction
+func (d *webhookDao) MoveToDeadLetters(delivery *model.WebhookDelivery, lastError string) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+		}
+	}()
+
+	_, err = tx.Exec("INSERT INTO webhook_dead_letters (subscription_id, event_type, payload, attempts, last_error, failed_at) VALUES (?, ?, ?, ?, ?, ?)",
+		delivery.SubscriptionId, delivery.EventType, delivery.Payload, delivery.Attempts, truncateError(lastError), time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM webhook_deliveries WHERE id = ?", delivery.Id)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetDeadLetters returns the dead letters, newest first, together with the total number of dead letters
+func (d *webhookDao) GetDeadLetters(limit int, offset int) (*[]model.WebhookDeadLetter, int, error) {
+	var total int
+	err := d.db.QueryRow("SELECT count(*) FROM webhook_dead_letters").Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := "SELECT id, subscription_id, event_type, payload, attempts, last_error, failed_at " +
+		"FROM webhook_dead_letters ORDER BY failed_at DESC, id DESC LIMIT ? OFFSET ?"
+	rows, err := d.db.Query(query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Print(err)
+		}
+	}(rows)
+
+	result := make([]model.WebhookDeadLetter, 0)
+	for rows.Next() {
+		var (
+			deadLetter model.WebhookDeadLetter
+			failedAt   time.Time
+		)
+		err = rows.Scan(&deadLetter.Id, &deadLetter.SubscriptionId, &deadLetter.EventType, &deadLetter.Payload,
+			&deadLetter.Attempts, &deadLetter.LastError, &failedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		t := failedAt.UTC()
+		deadLetter.FailedAt = &t
+		result = append(result, deadLetter)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+	return &result, total, nil
+}
+
+// RedeliverDeadLetter moves the dead letter back to the deliveries, to be attempted right away with a fresh
+// number of attempts. It returns false if the dead letter does not exist.
+func (d *webhookDao) RedeliverDeadLetter(id int64) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.Printf("Execute statement failed: %v, unable to rollback: %v", err, rollbackErr)
+			}
+			log.Print(err)
+		}
+	}()
+
+	res, err := tx.Exec("INSERT INTO webhook_deliveries (subscription_id, event_type, payload, attempts, next_attempt_at) "+
+		"SELECT subscription_id, event_type, payload, 0, ? FROM webhook_dead_letters WHERE id = ?", time.Now().UTC(), id)
+	if err != nil {
+		return false, err
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if inserted > 0 {
+		_, err = tx.Exec("DELETE FROM webhook_dead_letters WHERE id = ?", id)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("Commit failed: %v", err)
+		return false, err
+	}
+	return inserted > 0, nil
+}
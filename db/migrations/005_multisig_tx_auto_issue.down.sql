@@ -0,0 +1 @@
+ALTER TABLE multisig_tx DROP COLUMN auto_issue;
\ No newline at end of file
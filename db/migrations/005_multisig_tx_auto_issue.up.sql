@@ -0,0 +1 @@
+ALTER TABLE multisig_tx ADD COLUMN auto_issue BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
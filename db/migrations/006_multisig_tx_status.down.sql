@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx DROP COLUMN tx_status_updated_at;
+ALTER TABLE multisig_tx DROP COLUMN tx_status_reason;
+ALTER TABLE multisig_tx DROP COLUMN tx_status;
+ALTER TABLE multisig_tx DROP COLUMN issued_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx ADD COLUMN issued_at DATETIME NULL;
+ALTER TABLE multisig_tx ADD COLUMN tx_status VARCHAR(16) NULL;
+ALTER TABLE multisig_tx ADD COLUMN tx_status_reason VARCHAR(1024) NULL;
+ALTER TABLE multisig_tx ADD COLUMN tx_status_updated_at DATETIME NULL;
\ No newline at end of file
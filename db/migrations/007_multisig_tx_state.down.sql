@@ -0,0 +1,4 @@
+DROP TABLE multisig_tx_owners_archive;
+DROP TABLE multisig_tx_archive;
+DROP INDEX idx_multisig_tx_state ON multisig_tx;
+ALTER TABLE multisig_tx DROP COLUMN state;
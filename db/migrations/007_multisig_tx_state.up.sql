@@ -0,0 +1,60 @@
+ALTER TABLE multisig_tx ADD COLUMN state VARCHAR(32) NOT NULL DEFAULT 'pending';
+CREATE INDEX idx_multisig_tx_state ON multisig_tx (state);
+
+-- back-fill the state of existing txs
+UPDATE multisig_tx SET state = 'issued' WHERE transaction_id IS NOT NULL;
+UPDATE multisig_tx SET state = 'committed' WHERE transaction_id IS NOT NULL AND tx_status = 'Committed';
+UPDATE multisig_tx SET state = 'rejected' WHERE transaction_id IS NOT NULL AND tx_status IN ('Aborted', 'Dropped');
+UPDATE multisig_tx AS tx SET state = 'threshold_reached'
+WHERE tx.transaction_id IS NULL AND tx.threshold <= (SELECT count(*)
+                                                     FROM multisig_tx_owners AS owners
+                                                     WHERE owners.multisig_tx_id = tx.id AND owners.is_signer = TRUE);
+UPDATE multisig_tx SET state = 'expired' WHERE transaction_id IS NULL AND expires_at <= UTC_TIMESTAMP();
+
+CREATE TABLE multisig_tx_archive
+(
+    archive_id           BIGINT          NOT NULL AUTO_INCREMENT,
+    id                   CHAR(64)        NOT NULL,
+    unsigned_tx          VARCHAR(32768)  CHARACTER SET ascii NOT NULL,
+    alias                VARCHAR(255)    NOT NULL,
+    threshold            INT             NOT NULL,
+    chain_id             VARCHAR(50)     NOT NULL,
+    transaction_id       VARCHAR(56)     NULL,
+    parent_transaction   VARCHAR(56)     NULL,
+    output_owners        VARCHAR(16384)  CHARACTER SET ascii NOT NULL,
+    metadata             VARCHAR(255)    NOT NULL,
+    auto_issue           BOOLEAN         NOT NULL DEFAULT FALSE,
+    state                VARCHAR(32)     NOT NULL,
+    issued_at            DATETIME        NULL,
+    tx_status            VARCHAR(16)     NULL,
+    tx_status_reason     VARCHAR(1024)   NULL,
+    tx_status_updated_at DATETIME        NULL,
+    expires_at           DATETIME        NOT NULL,
+    created_at           DATETIME        NOT NULL,
+    archived_at          DATETIME        NOT NULL,
+    PRIMARY KEY (archive_id)
+);
+
+CREATE INDEX idx_multisig_tx_archive_alias ON multisig_tx_archive (alias);
+CREATE INDEX idx_multisig_tx_archive_id ON multisig_tx_archive (id);
+
+CREATE TABLE multisig_tx_owners_archive
+(
+    archive_id     BIGINT           NOT NULL,
+    address        CHAR(51)         NOT NULL,
+    signature      VARCHAR(255)     NULL,
+    is_signer      BOOLEAN          NOT NULL DEFAULT FALSE,
+    created_at     DATETIME         NOT NULL,
+    FOREIGN KEY (archive_id) REFERENCES multisig_tx_archive (archive_id),
+    PRIMARY KEY (archive_id, address)
+);
+
+-- move txs archived as '<expiration>_<id>' to the archive with their real id
+INSERT INTO multisig_tx_archive (id, unsigned_tx, alias, threshold, chain_id, transaction_id, parent_transaction,
+                                 output_owners, metadata, auto_issue, state, expires_at, created_at, archived_at)
+SELECT SUBSTRING(id, 21), unsigned_tx, alias, threshold, chain_id, transaction_id, parent_transaction,
+       output_owners, metadata, auto_issue, 'expired', expires_at, created_at, UTC_TIMESTAMP()
+FROM multisig_tx
+WHERE id LIKE '____-__-__T__:__:___%';
+DELETE FROM multisig_tx_owners WHERE multisig_tx_id LIKE '____-__-__T__:__:___%';
+DELETE FROM multisig_tx WHERE id LIKE '____-__-__T__:__:___%';
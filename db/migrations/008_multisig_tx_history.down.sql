@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx_archive DROP COLUMN state_updated_at;
+ALTER TABLE multisig_tx_archive DROP COLUMN issuer;
+ALTER TABLE multisig_tx DROP COLUMN state_updated_at;
+ALTER TABLE multisig_tx DROP COLUMN issuer;
@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx ADD COLUMN issuer CHAR(51) NULL;
+ALTER TABLE multisig_tx ADD COLUMN state_updated_at DATETIME NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN issuer CHAR(51) NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN state_updated_at DATETIME NULL;
@@ -0,0 +1 @@
+DROP TABLE multisig_tx_inputs;
@@ -0,0 +1,9 @@
+CREATE TABLE multisig_tx_inputs
+(
+    multisig_tx_id CHAR(84)         NOT NULL,
+    utxo_id        VARCHAR(64)      NOT NULL,
+    FOREIGN KEY (multisig_tx_id) REFERENCES multisig_tx (id),
+    PRIMARY KEY (multisig_tx_id, utxo_id)
+);
+
+CREATE INDEX idx_multisig_tx_inputs_utxo_id ON multisig_tx_inputs (utxo_id);
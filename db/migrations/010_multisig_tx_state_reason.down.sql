@@ -0,0 +1,2 @@
+ALTER TABLE multisig_tx_archive DROP COLUMN state_reason;
+ALTER TABLE multisig_tx DROP COLUMN state_reason;
@@ -0,0 +1,2 @@
+ALTER TABLE multisig_tx ADD COLUMN state_reason VARCHAR(1024) NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN state_reason VARCHAR(1024) NULL;
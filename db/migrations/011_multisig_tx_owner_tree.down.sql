@@ -0,0 +1,2 @@
+DROP TABLE multisig_tx_owner_tree_archive;
+DROP TABLE multisig_tx_owner_tree;
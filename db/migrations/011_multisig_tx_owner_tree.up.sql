@@ -0,0 +1,23 @@
+-- the owners of a tx whose alias has nested multisig aliases as owners, in depth-first order;
+-- aliases have a threshold, signer keys have none and are stored in multisig_tx_owners as well
+CREATE TABLE multisig_tx_owner_tree
+(
+    multisig_tx_id CHAR(84)         NOT NULL,
+    node_index     INT              NOT NULL,
+    parent_index   INT              NULL,
+    address        CHAR(51)         NOT NULL,
+    threshold      INT              NULL,
+    FOREIGN KEY (multisig_tx_id) REFERENCES multisig_tx (id),
+    PRIMARY KEY (multisig_tx_id, node_index)
+);
+
+CREATE TABLE multisig_tx_owner_tree_archive
+(
+    archive_id     BIGINT           NOT NULL,
+    node_index     INT              NOT NULL,
+    parent_index   INT              NULL,
+    address        CHAR(51)         NOT NULL,
+    threshold      INT              NULL,
+    FOREIGN KEY (archive_id) REFERENCES multisig_tx_archive (archive_id),
+    PRIMARY KEY (archive_id, node_index)
+);
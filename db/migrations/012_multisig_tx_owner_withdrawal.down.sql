@@ -0,0 +1,2 @@
+ALTER TABLE multisig_tx_owners_archive DROP COLUMN withdrawn_at;
+ALTER TABLE multisig_tx_owners DROP COLUMN withdrawn_at;
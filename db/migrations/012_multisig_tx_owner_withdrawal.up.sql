@@ -0,0 +1,2 @@
+ALTER TABLE multisig_tx_owners ADD COLUMN withdrawn_at DATETIME NULL;
+ALTER TABLE multisig_tx_owners_archive ADD COLUMN withdrawn_at DATETIME NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE multisig_tx_owners_archive DROP COLUMN rejected_at;
+ALTER TABLE multisig_tx_owners_archive DROP COLUMN rejection;
+ALTER TABLE multisig_tx_owners DROP COLUMN rejected_at;
+ALTER TABLE multisig_tx_owners DROP COLUMN rejection;
+
+ALTER TABLE multisig_tx_archive DROP COLUMN creator;
+ALTER TABLE multisig_tx DROP COLUMN creator;
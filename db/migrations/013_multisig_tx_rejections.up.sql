@@ -0,0 +1,7 @@
+ALTER TABLE multisig_tx ADD COLUMN creator CHAR(51) NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN creator CHAR(51) NULL;
+
+ALTER TABLE multisig_tx_owners ADD COLUMN rejection VARCHAR(255) NULL;
+ALTER TABLE multisig_tx_owners ADD COLUMN rejected_at DATETIME NULL;
+ALTER TABLE multisig_tx_owners_archive ADD COLUMN rejection VARCHAR(255) NULL;
+ALTER TABLE multisig_tx_owners_archive ADD COLUMN rejected_at DATETIME NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE multisig_tx_owners_archive DROP COLUMN cancel_voted_at;
+ALTER TABLE multisig_tx_owners DROP COLUMN cancel_voted_at;
+
+ALTER TABLE multisig_tx_archive DROP COLUMN cancelled_by;
+ALTER TABLE multisig_tx DROP COLUMN cancelled_by;
@@ -0,0 +1,5 @@
+ALTER TABLE multisig_tx ADD COLUMN cancelled_by CHAR(51) NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN cancelled_by CHAR(51) NULL;
+
+ALTER TABLE multisig_tx_owners ADD COLUMN cancel_voted_at DATETIME NULL;
+ALTER TABLE multisig_tx_owners_archive ADD COLUMN cancel_voted_at DATETIME NULL;
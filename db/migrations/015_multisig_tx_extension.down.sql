@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx_archive DROP COLUMN extended_at;
+ALTER TABLE multisig_tx_archive DROP COLUMN extended_by;
+ALTER TABLE multisig_tx DROP COLUMN extended_at;
+ALTER TABLE multisig_tx DROP COLUMN extended_by;
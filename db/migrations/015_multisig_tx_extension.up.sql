@@ -0,0 +1,4 @@
+ALTER TABLE multisig_tx ADD COLUMN extended_by CHAR(51) NULL;
+ALTER TABLE multisig_tx ADD COLUMN extended_at DATETIME NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN extended_by CHAR(51) NULL;
+ALTER TABLE multisig_tx_archive ADD COLUMN extended_at DATETIME NULL;
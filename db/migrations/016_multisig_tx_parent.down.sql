@@ -0,0 +1,3 @@
+DROP INDEX idx_multisig_tx_parent_transaction ON multisig_tx;
+ALTER TABLE multisig_tx_archive MODIFY COLUMN parent_transaction VARCHAR(56) NULL;
+ALTER TABLE multisig_tx MODIFY COLUMN parent_transaction VARCHAR(56) NULL;
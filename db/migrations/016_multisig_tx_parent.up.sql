@@ -0,0 +1,4 @@
+-- the parent can be referenced by the id of a stored multisig tx, which is longer than a tx id
+ALTER TABLE multisig_tx MODIFY COLUMN parent_transaction VARCHAR(64) NULL;
+ALTER TABLE multisig_tx_archive MODIFY COLUMN parent_transaction VARCHAR(64) NULL;
+CREATE INDEX idx_multisig_tx_parent_transaction ON multisig_tx (parent_transaction);
@@ -0,0 +1 @@
+DROP INDEX idx_multisig_tx_owners_address ON multisig_tx_owners;
@@ -0,0 +1,2 @@
+-- owners look up the pending txs of all their aliases by their address
+CREATE INDEX idx_multisig_tx_owners_address ON multisig_tx_owners (address);
@@ -0,0 +1,23 @@
+CREATE TABLE audit_events
+(
+    id               BIGINT          NOT NULL AUTO_INCREMENT,
+    action           VARCHAR(32)     NOT NULL,
+    multisig_tx_id   CHAR(64)        NULL,
+    alias            VARCHAR(255)    NULL,
+    deposit_offer_id CHAR(64)        NULL,
+    actor            VARCHAR(255)    NOT NULL,
+    signature        VARCHAR(255)    NULL,
+    request_id       VARCHAR(64)     NULL,
+    client_ip        VARCHAR(45)     NULL,
+    payload_hash     CHAR(64)        NULL,
+    created_at       DATETIME        NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_events_alias ON audit_events (alias, created_at);
+
+-- the audit log is append-only
+CREATE TRIGGER audit_events_no_update BEFORE UPDATE ON audit_events
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit events cannot be updated';
+CREATE TRIGGER audit_events_no_delete BEFORE DELETE ON audit_events
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit events cannot be deleted';
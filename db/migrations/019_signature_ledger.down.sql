@@ -0,0 +1,4 @@
+DROP TRIGGER signature_ledger_no_delete;
+DROP TRIGGER signature_ledger_no_update;
+DROP TABLE signature_ledger_head;
+DROP TABLE signature_ledger;
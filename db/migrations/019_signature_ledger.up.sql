@@ -0,0 +1,33 @@
+CREATE TABLE signature_ledger
+(
+    id         BIGINT          NOT NULL AUTO_INCREMENT,
+    operation  VARCHAR(16)     NOT NULL,
+    table_name VARCHAR(32)     NOT NULL,
+    record_id  CHAR(64)        NOT NULL,
+    address    CHAR(51)        NULL,
+    signature  VARCHAR(255)    NULL,
+    prev_hash  CHAR(64)        NOT NULL,
+    hash       CHAR(64)        NOT NULL,
+    created_at DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    -- an entry can only be followed by one other entry
+    UNIQUE (prev_hash)
+);
+
+CREATE INDEX idx_signature_ledger_record ON signature_ledger (table_name, record_id);
+
+-- the hash of the last entry, which is locked by every write so that the entries are appended one after the other
+CREATE TABLE signature_ledger_head
+(
+    id   TINYINT  NOT NULL,
+    hash CHAR(64) NOT NULL,
+    PRIMARY KEY (id)
+);
+
+INSERT INTO signature_ledger_head (id, hash) VALUES (1, REPEAT('0', 64));
+
+-- the ledger is append-only
+CREATE TRIGGER signature_ledger_no_update BEFORE UPDATE ON signature_ledger
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'signature ledger entries cannot be updated';
+CREATE TRIGGER signature_ledger_no_delete BEFORE DELETE ON signature_ledger
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'signature ledger entries cannot be deleted';
@@ -0,0 +1,40 @@
+CREATE TABLE webhook_subscriptions
+(
+    id         BIGINT          NOT NULL AUTO_INCREMENT,
+    alias      VARCHAR(255)    NULL,
+    url        VARCHAR(2048)   NOT NULL,
+    secret     CHAR(64)        NOT NULL,
+    events     VARCHAR(255)    NOT NULL DEFAULT '',
+    created_at DATETIME        NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_webhook_subscriptions_alias ON webhook_subscriptions (alias);
+
+CREATE TABLE webhook_deliveries
+(
+    id              BIGINT          NOT NULL AUTO_INCREMENT,
+    subscription_id BIGINT          NOT NULL,
+    event_type      VARCHAR(32)     NOT NULL,
+    payload         TEXT            NOT NULL,
+    attempts        INT             NOT NULL DEFAULT 0,
+    next_attempt_at DATETIME        NOT NULL,
+    last_error      VARCHAR(1024)   NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_deliveries_next_attempt ON webhook_deliveries (next_attempt_at);
+
+CREATE TABLE webhook_dead_letters
+(
+    id              BIGINT          NOT NULL AUTO_INCREMENT,
+    subscription_id BIGINT          NOT NULL,
+    event_type      VARCHAR(32)     NOT NULL,
+    payload         TEXT            NOT NULL,
+    attempts        INT             NOT NULL,
+    last_error      VARCHAR(1024)   NOT NULL,
+    failed_at       DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+);
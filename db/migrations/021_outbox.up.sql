@@ -0,0 +1,15 @@
+CREATE TABLE outbox
+(
+    id              BIGINT          NOT NULL AUTO_INCREMENT,
+    idempotency_key CHAR(32)        NOT NULL,
+    event_type      VARCHAR(32)     NOT NULL,
+    multisig_tx_id  CHAR(64)        NOT NULL,
+    alias           VARCHAR(255)    NOT NULL,
+    state           VARCHAR(32)     NOT NULL,
+    actor           VARCHAR(255)    NULL,
+    attempts        INT             NOT NULL DEFAULT 0,
+    last_error      VARCHAR(1024)   NULL,
+    created_at      DATETIME        NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY (idempotency_key)
+);
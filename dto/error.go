@@ -4,3 +4,9 @@ type SignavaultError struct {
 	Message string `json:"message" binding:"required"`
 	Error   string `json:"error" binding:"required"`
 }
+
+// ConflictingTxsError is returned if a tx spends utxos which are already spent by other pending txs
+type ConflictingTxsError struct {
+	SignavaultError
+	ConflictingTxIds []string `json:"conflictingTxIds"`
+}
@@ -5,6 +5,8 @@
 
 package dto
 
+import "github.com/chain4travel/camino-signavault/model"
+
 type MultisigTxArgs struct {
 	Alias             string `json:"alias" binding:"required"`
 	UnsignedTx        string `json:"unsignedTx" binding:"required"`
@@ -12,13 +14,34 @@ type MultisigTxArgs struct {
 	OutputOwners      string `json:"outputOwners" binding:"required"`
 	Metadata          string `json:"metadata"`
 	Expiration        int64  `json:"expiration"`
-	ParentTransaction string `json:"parentTransaction"`
+	ParentTransaction string `json:"parentTransaction"` // id of a stored multisig tx or of a tx on chain which has to be committed first
+	AutoIssue         bool   `json:"autoIssue"`         // issue the tx as soon as the signature threshold is reached
 }
 
 type SignTxArgs struct {
 	Signature string `json:"signature" binding:"required"`
 }
 
+type SignBatchArgs struct {
+	Signatures []SignBatchItem `json:"signatures" binding:"required,min=1,max=100,dive"`
+}
+
+type SignBatchItem struct {
+	Id        string `json:"id" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+type SignBatchResponse struct {
+	Results []SignBatchResult `json:"results"`
+}
+
+// SignBatchResult holds the outcome of a single item of a batch, either the signed tx or the reason it was not signed
+type SignBatchResult struct {
+	Id    string            `json:"id"`
+	Tx    *model.MultisigTx `json:"tx,omitempty"`
+	Error string            `json:"error,omitempty"`
+}
+
 type IssueTxArgs struct {
 	SignedTx  string `json:"signedTx" binding:"required"`
 	Signature string `json:"signature" binding:"required"`
@@ -28,8 +51,68 @@ type IssueTxResponse struct {
 	TxID string `json:"txID" binding:"required"`
 }
 
+type SignedTxResponse struct {
+	SignedTx string `json:"signedTx" binding:"required"`
+}
+
+type RejectTxArgs struct {
+	Timestamp string `json:"timestamp" binding:"required"`
+	Signature string `json:"signature" binding:"required"` // signature of the owner over "reject" + id + timestamp
+}
+
+type ExtendTxArgs struct {
+	Expiration int64  `json:"expiration" binding:"required"` // new expiration as unix timestamp
+	Timestamp  string `json:"timestamp" binding:"required"`
+	Signature  string `json:"signature" binding:"required"` // signature of the owner over "extend" + id + expiration + timestamp
+}
+
 type CancelTxArgs struct {
 	Id        string `json:"id" binding:"required"`
 	Timestamp string `json:"timestamp" binding:"required"`
 	Signature string `json:"signature" binding:"required"`
+	Reason    string `json:"reason" binding:"max=1024"`
+}
+
+type MultisigTxHistoryResponse struct {
+	Transactions []model.MultisigTx `json:"transactions"`
+	Total        int                `json:"total"`
+	Limit        int                `json:"limit"`
+	Offset       int                `json:"offset"`
+}
+
+// PendingTx is a tx waiting for the signature of an owner
+type PendingTx struct {
+	model.MultisigTx
+	Signatures int `json:"signatures"` // number of owners of the alias counted towards the threshold so far
+}
+
+type AuditEventsResponse struct {
+	Events []model.AuditEvent `json:"events"`
+	Total  int                `json:"total"`
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+}
+
+// LedgerProof links the signatures of a multisig tx to the head of the signature ledger
+type LedgerProof struct {
+	MultisigTxId string            `json:"multisigTxId"`
+	Head         string            `json:"head"`
+	Steps        []LedgerProofStep `json:"steps"`
+}
+
+// LedgerProofStep is an entry of the signature ledger. The entries of other records are only given by the hash
+// of their content, Hash is the hash of PrevHash followed by ContentHash.
+type LedgerProofStep struct {
+	Id          int64              `json:"id"`
+	PrevHash    string             `json:"prevHash"`
+	ContentHash string             `json:"contentHash"`
+	Hash        string             `json:"hash"`
+	Entry       *model.LedgerEntry `json:"entry,omitempty"`
+}
+
+// LedgerReport is the result of the verification of the signature ledger against the stored signatures
+type LedgerReport struct {
+	Entries  int      `json:"entries"`
+	Head     string   `json:"head"`
+	Problems []string `json:"problems"`
 }
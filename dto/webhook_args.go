@@ -0,0 +1,23 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package dto
+
+import "github.com/chain4travel/camino-signavault/model"
+
+// WebhookSubscriptionArgs subscribes the url to the events of an alias, or of all aliases if no alias is given.
+// If no events are given, all events are delivered.
+type WebhookSubscriptionArgs struct {
+	Alias  string                      `json:"alias"`
+	Url    string                      `json:"url" binding:"required"`
+	Events []model.MultisigTxEventType `json:"events"`
+}
+
+type WebhookDeadLettersResponse struct {
+	DeadLetters []model.WebhookDeadLetter `json:"deadLetters"`
+	Total       int                       `json:"total"`
+	Limit       int                       `json:"limit"`
+	Offset      int                       `json:"offset"`
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/gin-gonic/gin"
+)
+
+const apiKeyHeader = "X-Api-Key"
+
+// ApiKey only lets requests through which carry the given key in the X-Api-Key header. If no key is configured,
+// all requests are rejected, so that the guarded endpoints are disabled by default.
+func ApiKey(key string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if key == "" || subtle.ConstantTimeCompare([]byte(ctx.GetHeader(apiKeyHeader)), []byte(key)) != 1 {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized,
+				&dto.SignavaultError{
+					Message: "Missing or invalid api key",
+					Error:   "unauthorized",
+				})
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		header   string
+		wantCode int
+	}{
+		{
+			name:     "valid api key",
+			key:      "key",
+			header:   "key",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "invalid api key - should fail",
+			key:      "key",
+			header:   "other",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "missing api key - should fail",
+			key:      "key",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "no api key configured - should fail",
+			key:      "",
+			header:   "",
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ApiKey(tt.key))
+			router.GET("/", func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(apiKeyHeader, tt.header)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
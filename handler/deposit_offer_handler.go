@@ -52,7 +52,7 @@ func (h *depositOfferHandler) AddSignature(ctx *gin.Context) {
 		return
 	}
 
-	err = h.DepositOfferService.AddSignatures(args)
+	err = h.DepositOfferService.AddSignatures(args, requestInfo(ctx))
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest,
 			&dto.SignavaultError{
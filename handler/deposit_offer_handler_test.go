@@ -22,8 +22,8 @@ func TestDepositOfferHandlerAddSignature(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockDepositOfferService := service.NewMockDepositOfferService(ctrl)
 
-	mockDepositOfferService.EXPECT().AddSignatures(gomock.Any()).Return(nil).Times(1)
-	mockDepositOfferService.EXPECT().AddSignatures(gomock.Any()).Return(fmt.Errorf("error")).Times(1)
+	mockDepositOfferService.EXPECT().AddSignatures(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockDepositOfferService.EXPECT().AddSignatures(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error")).Times(1)
 
 	type fields struct {
 		DepositOfferService service.DepositOfferService
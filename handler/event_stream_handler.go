@@ -0,0 +1,144 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// eventStreamKeepAlive is the interval of the comments sent on an idle event stream, which keep proxies from
+// closing the connection
+const eventStreamKeepAlive = 15 * time.Second
+
+// StreamEvents godoc
+// @Summary Streams the lifecycle events of the multisig transactions of a given alias as server-sent events
+// @Tags Multisig
+// @Param alias path string true "Alias of the multisig account"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  text/event-stream
+// @Success 200 {object} model.MultisigTxEvent
+// @Failure 400 {object} dto.SignavaultError
+// @ID StreamEvents
+// @Router /multisig/{alias}/events [get]
+func (h *multisigHandler) StreamEvents(ctx *gin.Context) {
+	events, unsubscribe, ok := h.subscribeEvents(ctx)
+	if !ok {
+		return
+	}
+	defer unsubscribe()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			ctx.SSEvent(string(event.Type), event)
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		}
+	})
+}
+
+// StreamEventsWebSocket godoc
+// @Summary Streams the lifecycle events of the multisig transactions of a given alias as json messages over a websocket
+// @Tags Multisig
+// @Param alias path string true "Alias of the multisig account"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Success 101 {object} model.MultisigTxEvent
+// @Failure 400 {object} dto.SignavaultError
+// @ID StreamEventsWebSocket
+// @Router /multisig/{alias}/events/ws [get]
+func (h *multisigHandler) StreamEventsWebSocket(ctx *gin.Context) {
+	events, unsubscribe, ok := h.subscribeEvents(ctx)
+	if !ok {
+		return
+	}
+	defer unsubscribe()
+
+	// the request is authorized by its signature, so any origin is accepted like for the other endpoints
+	server := websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			defer func() {
+				if err := conn.Close(); err != nil {
+					log.Print(err)
+				}
+			}()
+
+			// messages of the client are ignored, reading only detects when the client goes away
+			closed := make(chan struct{})
+			go func() {
+				_, _ = io.Copy(io.Discard, conn)
+				close(closed)
+			}()
+
+			for {
+				select {
+				case <-closed:
+					return
+				case event, ok := <-events:
+					if !ok {
+						return
+					}
+					if err := websocket.JSON.Send(conn, event); err != nil {
+						return
+					}
+				}
+			}
+		},
+	}
+	server.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// subscribeEvents subscribes to the events of the alias in the path, writing the error response if the request
+// isn't authorized
+func (h *multisigHandler) subscribeEvents(ctx *gin.Context) (<-chan model.MultisigTxEvent, func(), bool) {
+	alias := ctx.Param("alias")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return nil, nil, false
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return nil, nil, false
+	}
+
+	events, unsubscribe, err := h.multisigService.SubscribeEvents(alias, timestamp, signature)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error subscribing to events for alias %s", alias),
+				Error:   err.Error(),
+			})
+		return nil, nil, false
+	}
+	return events, unsubscribe, true
+}
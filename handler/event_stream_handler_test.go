@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/service"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	streamAlias     = "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	streamTimestamp = "1678877386"
+)
+
+// closedEventStream returns a stream which delivers the given events and ends
+func closedEventStream(events ...model.MultisigTxEvent) <-chan model.MultisigTxEvent {
+	stream := make(chan model.MultisigTxEvent, len(events))
+	for _, event := range events {
+		stream <- event
+	}
+	close(stream)
+	return stream
+}
+
+func newEventStreamServer(t *testing.T, mockMultisigService *service.MockMultisigService) *httptest.Server {
+	h := NewMultisigHandler(mockMultisigService)
+	router := gin.New()
+	router.GET("/multisig/:alias/events", h.StreamEvents)
+	router.GET("/multisig/:alias/events/ws", h.StreamEventsWebSocket)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestStreamEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	server := newEventStreamServer(t, mockMultisigService)
+
+	event := model.MultisigTxEvent{Type: model.MultisigTxEventSigned, TxId: "1", Alias: streamAlias}
+	eventAsJson, _ := json.Marshal(event)
+	unsubscribed := false
+	mockMultisigService.EXPECT().SubscribeEvents(streamAlias, streamTimestamp, "signature").Return(closedEventStream(event), func() { unsubscribed = true }, nil).Times(1)
+	mockMultisigService.EXPECT().SubscribeEvents(streamAlias, streamTimestamp, "invalid").Return(nil, nil, service.ErrParsingSignature).Times(1)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "stream events",
+			query:    "?timestamp=" + streamTimestamp + "&signature=signature",
+			wantCode: http.StatusOK,
+			wantBody: "event:signed\ndata:" + string(eventAsJson) + "\n\n",
+		},
+		{
+			name:     "stream events with invalid signature - should fail",
+			query:    "?timestamp=" + streamTimestamp + "&signature=invalid",
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrParsingSignature.Error(),
+		},
+		{
+			name:     "stream events without signature - should fail",
+			query:    "?timestamp=" + streamTimestamp,
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(server.URL + "/multisig/" + streamAlias + "/events" + tt.query)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.wantCode, resp.StatusCode)
+			require.Contains(t, string(body), tt.wantBody)
+			if tt.wantCode == http.StatusOK {
+				require.True(t, strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream"))
+			}
+		})
+	}
+	require.True(t, unsubscribed)
+}
+
+func TestStreamEventsWebSocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	server := newEventStreamServer(t, mockMultisigService)
+
+	event := model.MultisigTxEvent{Type: model.MultisigTxEventCancelled, TxId: "1", Alias: streamAlias}
+	mockMultisigService.EXPECT().SubscribeEvents(streamAlias, streamTimestamp, "signature").Return(closedEventStream(event), func() {}, nil).Times(1)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/multisig/" + streamAlias + "/events/ws?timestamp=" + streamTimestamp + "&signature=signature"
+	conn, err := websocket.Dial(url, "", server.URL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var got model.MultisigTxEvent
+	require.NoError(t, websocket.JSON.Receive(conn, &got))
+	require.Equal(t, event, got)
+
+	// the connection is closed once the subscription ends
+	require.ErrorIs(t, websocket.JSON.Receive(conn, &got), io.EOF)
+}
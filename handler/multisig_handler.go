@@ -6,10 +6,13 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
 	"github.com/chain4travel/camino-signavault/service"
 	"github.com/gin-gonic/gin"
 )
@@ -17,7 +20,21 @@ import (
 type MultisigHandler interface {
 	CreateMultisigTx(ctx *gin.Context)
 	GetAllMultisigTxForAlias(ctx *gin.Context)
+	GetMultisigTxHistory(ctx *gin.Context)
+	GetAuditEvents(ctx *gin.Context)
+	StreamEvents(ctx *gin.Context)
+	StreamEventsWebSocket(ctx *gin.Context)
+	GetPendingMultisigTxForOwner(ctx *gin.Context)
+	GetMultisigTx(ctx *gin.Context)
+	GetDecodedMultisigTx(ctx *gin.Context)
+	GetChildMultisigTxs(ctx *gin.Context)
+	GetSignatureProof(ctx *gin.Context)
+	GetSignedMultisigTx(ctx *gin.Context)
 	SignMultisigTx(ctx *gin.Context)
+	SignMultisigTxBatch(ctx *gin.Context)
+	WithdrawSignature(ctx *gin.Context)
+	RejectMultisigTx(ctx *gin.Context)
+	ExtendMultisigTx(ctx *gin.Context)
 	IssueMultisigTx(ctx *gin.Context)
 	CancelMultisigTx(ctx *gin.Context)
 }
@@ -40,6 +57,7 @@ func NewMultisigHandler(multisigService service.MultisigService) MultisigHandler
 // @Param multisigTxArgs body dto.MultisigTxArgs true "The input parameters for the multisig transaction"
 // @Success 201 {object} model.MultisigTx
 // @Failure 400 {object} dto.SignavaultError
+// @Failure 409 {object} dto.ConflictingTxsError
 // @ID CreateMultisigTx
 // @Router /multisig [post]
 func (h *multisigHandler) CreateMultisigTx(ctx *gin.Context) {
@@ -54,8 +72,20 @@ func (h *multisigHandler) CreateMultisigTx(ctx *gin.Context) {
 		return
 	}
 
-	response, err := h.multisigService.CreateMultisigTx(args)
+	response, err := h.multisigService.CreateMultisigTx(args, requestInfo(ctx))
 	if err != nil {
+		var conflictErr *service.ConflictingInputsError
+		if errors.As(err, &conflictErr) {
+			ctx.JSON(http.StatusConflict,
+				&dto.ConflictingTxsError{
+					SignavaultError: dto.SignavaultError{
+						Message: "Error creating multisig transaction",
+						Error:   err.Error(),
+					},
+					ConflictingTxIds: conflictErr.TxIds,
+				})
+			return
+		}
 		ctx.JSON(http.StatusBadRequest,
 			&dto.SignavaultError{
 				Message: "Error creating multisig transaction",
@@ -102,6 +132,345 @@ func (h *multisigHandler) GetAllMultisigTxForAlias(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, multisigTx)
 }
 
+// GetPendingMultisigTxForOwner godoc
+// @Summary Retrieves the pending multisig transactions of all aliases which have not been signed by the given owner yet
+// @Tags Multisig
+// @Param address path string true "Address of the owner"
+// @Param signature query string true "Signature of the owner over address + timestamp"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {array} dto.PendingTx
+// @Failure 400 {object}  dto.SignavaultError
+// @ID GetPendingMultisigTxForOwner
+// @Router /owners/{address}/pending [get]
+func (h *multisigHandler) GetPendingMultisigTxForOwner(ctx *gin.Context) {
+	address := ctx.Param("address")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	pendingTxs, err := h.multisigService.GetPendingMultisigTxForOwner(address, timestamp, signature)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting pending multisig transactions for owner %s", address),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, pendingTxs)
+}
+
+// GetMultisigTxHistory godoc
+// @Summary Retrieves the past multisig transactions (issued, cancelled, expired and archived) for a given alias
+// @Tags Multisig
+// @Param alias path string true "Alias of the multisig account"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Param limit query int false "Maximum number of transactions to return"
+// @Param offset query int false "Number of transactions to skip"
+// @Produce  json
+// @Success 200 {object} dto.MultisigTxHistoryResponse
+// @Failure 400 {object}  dto.SignavaultError
+// @ID GetMultisigTxHistory
+// @Router /multisig/{alias}/history [get]
+func (h *multisigHandler) GetMultisigTxHistory(ctx *gin.Context) {
+	alias := ctx.Param("alias")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "limit", err)
+		return
+	}
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "offset", err)
+		return
+	}
+
+	history, err := h.multisigService.GetMultisigTxHistory(alias, timestamp, signature, limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting multisig transaction history for alias %s", alias),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, history)
+}
+
+// GetAuditEvents godoc
+// @Summary Retrieves the audit log of the multisig transactions and their signatures for a given alias, newest first
+// @Tags Multisig
+// @Param alias path string true "Alias of the multisig account"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Param limit query int false "Maximum number of events to return"
+// @Param offset query int false "Number of events to skip"
+// @Produce  json
+// @Success 200 {object} dto.AuditEventsResponse
+// @Failure 400 {object}  dto.SignavaultError
+// @ID GetAuditEvents
+// @Router /multisig/{alias}/audit [get]
+func (h *multisigHandler) GetAuditEvents(ctx *gin.Context) {
+	alias := ctx.Param("alias")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "limit", err)
+		return
+	}
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "offset", err)
+		return
+	}
+
+	events, err := h.multisigService.GetAuditEvents(alias, timestamp, signature, limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting audit events for alias %s", alias),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, events)
+}
+
+// GetMultisigTx godoc
+// @Summary Retrieves a multisig transaction by its id
+// @Tags Multisig
+// @Param id path string true "Multisig transaction ID"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {object} model.MultisigTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID GetMultisigTx
+// @Router /multisig/tx/{id} [get]
+func (h *multisigHandler) GetMultisigTx(ctx *gin.Context) {
+	id := ctx.Param("id")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	multisigTx, err := h.multisigService.GetMultisigTxForOwner(id, timestamp, signature)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, multisigTx)
+}
+
+// GetDecodedMultisigTx godoc
+// @Summary Decodes the unsigned transaction of a multisig transaction
+// @Tags Multisig
+// @Param id path string true "Multisig transaction ID"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {object} model.DecodedTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID GetDecodedMultisigTx
+// @Router /multisig/tx/{id}/decoded [get]
+func (h *multisigHandler) GetDecodedMultisigTx(ctx *gin.Context) {
+	id := ctx.Param("id")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	decodedTx, err := h.multisigService.GetDecodedMultisigTx(id, timestamp, signature)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error decoding multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, decodedTx)
+}
+
+// GetChildMultisigTxs godoc
+// @Summary Retrieves the multisig transactions which reference a multisig transaction as their parent
+// @Tags Multisig
+// @Param id path string true "Multisig transaction ID of the parent"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {array} model.MultisigTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID GetChildMultisigTxs
+// @Router /multisig/tx/{id}/children [get]
+func (h *multisigHandler) GetChildMultisigTxs(ctx *gin.Context) {
+	id := ctx.Param("id")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	children, err := h.multisigService.GetChildMultisigTxs(id, timestamp, signature)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting children of multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, children)
+}
+
+// GetSignatureProof godoc
+// @Summary Retrieves the proof that the signatures of a multisig transaction are included in the signature ledger
+// @Description The steps lead from the first signature of the transaction to the head of the ledger, the entries of the transaction are given in full
+// @Tags Multisig
+// @Param id path string true "Multisig transaction ID"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {object} dto.LedgerProof
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID GetSignatureProof
+// @Router /multisig/tx/{id}/proof [get]
+func (h *multisigHandler) GetSignatureProof(ctx *gin.Context) {
+	id := ctx.Param("id")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	proof, err := h.multisigService.GetSignatureProof(id, timestamp, signature)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error getting signature proof of multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, proof)
+}
+
+// GetSignedMultisigTx godoc
+// @Summary Assembles the signed transaction from the collected owner signatures
+// @Tags Multisig
+// @Param id path string true "Multisig transaction ID"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Produce  json
+// @Success 200 {object} dto.SignedTxResponse
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID GetSignedMultisigTx
+// @Router /multisig/{id}/signed-tx [get]
+func (h *multisigHandler) GetSignedMultisigTx(ctx *gin.Context) {
+	// the wildcard shares its name with the one of GET /multisig/:alias
+	id := ctx.Param("alias")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	signedTx, err := h.multisigService.GetSignedMultisigTx(id, timestamp, signature)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error assembling signed transaction for multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, &dto.SignedTxResponse{SignedTx: signedTx})
+}
+
 // SignMultisigTx godoc
 // @Summary Signs a multisig transaction
 // @Tags Multisig
@@ -111,6 +480,8 @@ func (h *multisigHandler) GetAllMultisigTxForAlias(ctx *gin.Context) {
 // @Param signTxArgs body dto.SignTxArgs true "Signer details"
 // @Success 200 {object} model.MultisigTx
 // @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @Failure 409 {object} dto.SignavaultError
 // @ID SignMultisigTx
 // @Router /multisig/{id} [put]
 func (h *multisigHandler) SignMultisigTx(ctx *gin.Context) {
@@ -128,11 +499,14 @@ func (h *multisigHandler) SignMultisigTx(ctx *gin.Context) {
 		return
 	}
 
-	multisigAlias, err := h.multisigService.SignMultisigTx(id, signer)
+	multisigAlias, err := h.multisigService.SignMultisigTx(id, signer, requestInfo(ctx))
 	if err != nil {
 		code := http.StatusBadRequest
-		if err == service.ErrTxNotExists {
+		switch err {
+		case service.ErrTxNotExists:
 			code = http.StatusNotFound
+		case service.ErrAliasChanged:
+			code = http.StatusConflict
 		}
 		ctx.JSON(code,
 			&dto.SignavaultError{
@@ -144,6 +518,175 @@ func (h *multisigHandler) SignMultisigTx(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, multisigAlias)
 }
 
+// SignMultisigTxBatch godoc
+// @Summary Signs several multisig transactions at once
+// @Description The valid signatures are stored together, the result of each item contains either the signed tx or the reason it was not signed
+// @Tags Multisig
+// @Accept json
+// @Produce  json
+// @Param signBatchArgs body dto.SignBatchArgs true "Pairs of multisig transaction ID and signature"
+// @Success 200 {object} dto.SignBatchResponse
+// @Failure 400 {object} dto.SignavaultError
+// @ID SignMultisigTxBatch
+// @Router /multisig/sign-batch [post]
+func (h *multisigHandler) SignMultisigTxBatch(ctx *gin.Context) {
+	var signBatchArgs *dto.SignBatchArgs
+	err := ctx.BindJSON(&signBatchArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error parsing signatures from JSON",
+				Error:   err.Error(),
+			})
+		return
+	}
+
+	response, err := h.multisigService.SignMultisigTxBatch(signBatchArgs, requestInfo(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error adding signers to multisig transactions",
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// WithdrawSignature godoc
+// @Summary Withdraws the signature of an owner from a multisig transaction which has not been issued yet
+// @Tags Multisig
+// @Produce json
+// @Param id path string true "Multisig transaction ID"
+// @Param signature query string true "Signature for the request"
+// @Param timestamp query string true "Timestamp for the request"
+// @Success 200 {object} model.MultisigTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @Failure 409 {object} dto.SignavaultError
+// @ID WithdrawSignature
+// @Router /multisig/tx/{id}/signature [delete]
+func (h *multisigHandler) WithdrawSignature(ctx *gin.Context) {
+	id := ctx.Param("id")
+	signature, b := ctx.GetQuery("signature")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "signature")
+		return
+	}
+	timestamp, b := ctx.GetQuery("timestamp")
+	if !b {
+		h.throwMissingQueryParamError(ctx, "timestamp")
+		return
+	}
+
+	multisigTx, err := h.multisigService.WithdrawSignature(id, timestamp, signature, requestInfo(ctx))
+	if err != nil {
+		code := http.StatusBadRequest
+		switch err {
+		case service.ErrTxNotExists:
+			code = http.StatusNotFound
+		case service.ErrInvalidStateTransition:
+			code = http.StatusConflict
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error withdrawing signature from multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, multisigTx)
+}
+
+// RejectMultisigTx godoc
+// @Summary Stores the vote of an owner to reject a multisig transaction
+// @Description The transaction is moved to the rejected state once the owners who have not rejected it cannot reach its threshold anymore
+// @Tags Multisig
+// @Accept json
+// @Produce json
+// @Param id path string true "Multisig transaction ID"
+// @Param rejectTxArgs body dto.RejectTxArgs true "The rejection signed by an owner"
+// @Success 200 {object} model.MultisigTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID RejectMultisigTx
+// @Router /multisig/tx/{id}/reject [post]
+func (h *multisigHandler) RejectMultisigTx(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	var rejectTxArgs *dto.RejectTxArgs
+	err := ctx.BindJSON(&rejectTxArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error parsing JSON for rejecting multisig transaction",
+				Error:   err.Error(),
+			})
+		return
+	}
+
+	multisigTx, err := h.multisigService.RejectMultisigTx(id, rejectTxArgs, requestInfo(ctx))
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrTxNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error rejecting multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, multisigTx)
+}
+
+// ExtendMultisigTx godoc
+// @Summary Moves the expiration of a pending multisig transaction further out
+// @Tags Multisig
+// @Accept json
+// @Produce json
+// @Param id path string true "Multisig transaction ID"
+// @Param extendTxArgs body dto.ExtendTxArgs true "The new expiration signed by an owner"
+// @Success 200 {object} model.MultisigTx
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @Failure 409 {object} dto.SignavaultError
+// @ID ExtendMultisigTx
+// @Router /multisig/tx/{id}/extend [post]
+func (h *multisigHandler) ExtendMultisigTx(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	var extendTxArgs *dto.ExtendTxArgs
+	err := ctx.BindJSON(&extendTxArgs)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error parsing JSON for extending multisig transaction",
+				Error:   err.Error(),
+			})
+		return
+	}
+
+	multisigTx, err := h.multisigService.ExtendMultisigTx(id, extendTxArgs, requestInfo(ctx))
+	if err != nil {
+		code := http.StatusBadRequest
+		switch err {
+		case service.ErrTxNotExists:
+			code = http.StatusNotFound
+		case service.ErrTxNotPending:
+			code = http.StatusConflict
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error extending expiration of multisig transaction with id %s", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, multisigTx)
+}
+
 // IssueMultisigTx issues a new multisig transaction with the given parameters.
 // @Summary Issue a new multisig transaction
 // @Tags Multisig
@@ -152,6 +695,8 @@ func (h *multisigHandler) SignMultisigTx(ctx *gin.Context) {
 // @Param issueTxArgs body dto.IssueTxArgs true "IssueTxArgs object that contains the parameters for the multisig transaction to be issued"
 // @Success 200 {object} dto.IssueTxResponse
 // @Failure 400 {object} dto.SignavaultError
+// @Failure 409 {object} dto.SignavaultError
+// @Failure 422 {object} dto.SignavaultError
 // @ID IssueMultisigTx
 // @Router /multisig/issue [post]
 func (h *multisigHandler) IssueMultisigTx(ctx *gin.Context) {
@@ -166,9 +711,17 @@ func (h *multisigHandler) IssueMultisigTx(ctx *gin.Context) {
 		return
 	}
 
-	txID, err := h.multisigService.IssueMultisigTx(issueTxArgs)
+	txID, err := h.multisigService.IssueMultisigTx(issueTxArgs, requestInfo(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest,
+		code := http.StatusBadRequest
+		switch err {
+		case service.ErrThresholdNotReached, service.ErrInvalidStateTransition, service.ErrAliasChanged,
+			service.ErrParentTxNotCommitted, service.ErrParentTxFailed:
+			code = http.StatusConflict
+		case service.ErrCredentialMismatch:
+			code = http.StatusUnprocessableEntity
+		}
+		ctx.JSON(code,
 			&dto.SignavaultError{
 				Message: "Error issuing multisig transaction",
 				Error:   err.Error(),
@@ -178,14 +731,18 @@ func (h *multisigHandler) IssueMultisigTx(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, &dto.IssueTxResponse{TxID: txID.String()})
 }
 
-// CancelMultisigTx Cancels a multisig transaction by setting the expiration to the current time.
-// @Summary Cancel a multisig transaction by setting the expiration to the current time
+// CancelMultisigTx Cancels a multisig transaction, or casts the cancel vote of an owner if a quorum of owners is required.
+// @Summary Cancel a multisig transaction or vote to cancel it
 // @Tags Multisig
 // @Accept json
 // @Produce json
 // @Param cancelTxArgs body dto.CancelTxArgs true "CancelTxArgs object that contains the parameters for the multisig transaction to be canceled"
 // @Success 204
+// @Success 202 {object} model.MultisigTx
 // @Failure 400 {object} dto.SignavaultError
+// @Failure 403 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @Failure 409 {object} dto.SignavaultError
 // @ID CancelMultisigTx
 // @Router /multisig/cancel [post]
 func (h *multisigHandler) CancelMultisigTx(ctx *gin.Context) {
@@ -200,15 +757,29 @@ func (h *multisigHandler) CancelMultisigTx(ctx *gin.Context) {
 		return
 	}
 
-	err = h.multisigService.CancelMultisigTx(cancelTxArgs)
+	multisigTx, err := h.multisigService.CancelMultisigTx(cancelTxArgs, requestInfo(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest,
+		code := http.StatusBadRequest
+		switch err {
+		case service.ErrNotCreator:
+			code = http.StatusForbidden
+		case service.ErrTxNotExists:
+			code = http.StatusNotFound
+		case service.ErrInvalidStateTransition, service.ErrOwnerHasVotedToCancel:
+			code = http.StatusConflict
+		}
+		ctx.JSON(code,
 			&dto.SignavaultError{
 				Message: "Error canceling multisig transaction",
 				Error:   err.Error(),
 			})
 		return
 	}
+	if multisigTx.State != model.MultisigTxStateCancelled {
+		// the cancel vote has been stored but the quorum has not been reached yet
+		ctx.JSON(http.StatusAccepted, multisigTx)
+		return
+	}
 	ctx.Status(http.StatusNoContent)
 }
 
@@ -219,3 +790,11 @@ func (h *multisigHandler) throwMissingQueryParamError(ctx *gin.Context, param st
 			Error:   "missing query parameter",
 		})
 }
+
+func (h *multisigHandler) throwInvalidQueryParamError(ctx *gin.Context, param string, err error) {
+	ctx.JSON(http.StatusBadRequest,
+		&dto.SignavaultError{
+			Message: fmt.Sprintf("Invalid query parameter '%s'", param),
+			Error:   err.Error(),
+		})
+}
@@ -13,6 +13,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -46,7 +47,14 @@ func TestCreateMultisigTx(t *testing.T) {
 			},
 		},
 	}
-	mockMultisigService.EXPECT().CreateMultisigTx(gomock.Any()).Return(mock, nil).AnyTimes()
+	conflictingArgs := &dto.MultisigTxArgs{
+		Alias:        "P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99e",
+		UnsignedTx:   mock.UnsignedTx,
+		Signature:    mock.Owners[0].Signature,
+		OutputOwners: "OutputOwners",
+	}
+	mockMultisigService.EXPECT().CreateMultisigTx(conflictingArgs, gomock.Any()).Return(nil, &service.ConflictingInputsError{TxIds: []string{"conflictingTxId"}}).Times(1)
+	mockMultisigService.EXPECT().CreateMultisigTx(gomock.Any(), gomock.Any()).Return(mock, nil).AnyTimes()
 	mockAsJson, _ := json.Marshal(mock)
 
 	type args struct {
@@ -75,6 +83,20 @@ func TestCreateMultisigTx(t *testing.T) {
 			wantBody: string(mockAsJson),
 			isError:  false,
 		},
+		{
+			name: "create multisig tx spending utxos of a pending tx - should fail",
+			args: args{
+				Body: ` {
+						"unsignedTx": "000000002004000003ea010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+						"alias": "P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99e",
+						"signature": "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
+						"outputOwners": "OutputOwners"
+						}`,
+			},
+			wantCode: http.StatusConflict,
+			wantBody: `"conflictingTxIds":["conflictingTxId"]`,
+			isError:  true,
+		},
 		{
 			name: "create multisig tx with empty signature - should fail",
 			args: args{
@@ -225,6 +247,104 @@ func TestGetAllMultisigTxForAlias(t *testing.T) {
 	}
 }
 
+func TestGetPendingMultisigTxForOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	now := time.Now().UTC()
+	owner := "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"
+	mockResult := &[]dto.PendingTx{
+		{
+			MultisigTx: model.MultisigTx{
+				Id:         "1",
+				Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+				Threshold:  2,
+				State:      model.MultisigTxStatePending,
+				Expiration: &now,
+				Owners: []model.MultisigTxOwner{
+					{
+						MultisigTxId: "1",
+						Address:      owner,
+					},
+				},
+				Timestamp: &now,
+			},
+			Signatures: 1,
+		},
+	}
+	mockResultAsJson, _ := json.Marshal(mockResult)
+
+	mockMultisigService.EXPECT().GetPendingMultisigTxForOwner(owner, "1678877386", "signature").Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().GetPendingMultisigTxForOwner("other", "1678877386", "signature").Return(nil, service.ErrAddressNotSigner).Times(1)
+
+	tests := []struct {
+		name     string
+		address  string
+		query    string
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name:     "get pending multisig txs for owner",
+			address:  owner,
+			query:    "?signature=signature&timestamp=1678877386",
+			wantCode: http.StatusOK,
+			wantBody: string(mockResultAsJson),
+			isError:  false,
+		},
+		{
+			name:     "get pending multisig txs signed by another address - should fail",
+			address:  "other",
+			query:    "?signature=signature&timestamp=1678877386",
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrAddressNotSigner.Error(),
+			isError:  true,
+		},
+		{
+			name:     "get pending multisig txs without signature - should fail",
+			address:  owner,
+			query:    "?timestamp=1678877386",
+			wantCode: http.StatusBadRequest,
+			wantBody: "signature",
+			isError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "address",
+					Value: tt.address,
+				},
+			}
+
+			h.GetPendingMultisigTxForOwner(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
 func TestIssueMultisigTx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockMultisigService := service.NewMockMultisigService(ctrl)
@@ -242,7 +362,21 @@ func TestIssueMultisigTx(t *testing.T) {
 	}
 	reqAsJson, _ := json.Marshal(req)
 
-	mockMultisigService.EXPECT().IssueMultisigTx(req).Return(txId, nil).AnyTimes()
+	mockMultisigService.EXPECT().IssueMultisigTx(req, gomock.Any()).Return(txId, nil).AnyTimes()
+
+	reqBelowThreshold := &dto.IssueTxArgs{
+		SignedTx:  "aaaaa",
+		Signature: "ccccc",
+	}
+	reqBelowThresholdAsJson, _ := json.Marshal(reqBelowThreshold)
+	mockMultisigService.EXPECT().IssueMultisigTx(reqBelowThreshold, gomock.Any()).Return(ids.Empty, service.ErrThresholdNotReached).AnyTimes()
+
+	reqCredentialMismatch := &dto.IssueTxArgs{
+		SignedTx:  "aaaaa",
+		Signature: "ddddd",
+	}
+	reqCredentialMismatchAsJson, _ := json.Marshal(reqCredentialMismatch)
+	mockMultisigService.EXPECT().IssueMultisigTx(reqCredentialMismatch, gomock.Any()).Return(ids.Empty, service.ErrCredentialMismatch).AnyTimes()
 
 	type args struct {
 		Body string
@@ -263,6 +397,24 @@ func TestIssueMultisigTx(t *testing.T) {
 			wantBody: string(resultAsJson),
 			isError:  false,
 		},
+		{
+			name: "issue multisig tx below threshold - should fail",
+			args: args{
+				Body: string(reqBelowThresholdAsJson),
+			},
+			wantCode: http.StatusConflict,
+			wantBody: service.ErrThresholdNotReached.Error(),
+			isError:  true,
+		},
+		{
+			name: "issue multisig tx with mismatching credentials - should fail",
+			args: args{
+				Body: string(reqCredentialMismatchAsJson),
+			},
+			wantCode: http.StatusUnprocessableEntity,
+			wantBody: service.ErrCredentialMismatch.Error(),
+			isError:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,7 +471,8 @@ func TestSignMultisigTx(t *testing.T) {
 	}
 	reqAsJson, _ := json.Marshal(req)
 
-	mockMultisigService.EXPECT().SignMultisigTx(mockResult.Id, req).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().SignMultisigTx(mockResult.Id, req, gomock.Any()).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().SignMultisigTx("2", req, gomock.Any()).Return(nil, service.ErrAliasChanged).Times(1)
 
 	type args struct {
 		id   string
@@ -342,6 +495,16 @@ func TestSignMultisigTx(t *testing.T) {
 			wantBody: string(resultAsJson),
 			isError:  false,
 		},
+		{
+			name: "sign multisig tx with changed alias - should fail",
+			args: args{
+				id:   "2",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusConflict,
+			wantBody: service.ErrAliasChanged.Error(),
+			isError:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -375,30 +538,1333 @@ func TestSignMultisigTx(t *testing.T) {
 	}
 }
 
-func TestNewMultisigHandler(t *testing.T) {
-	type args struct {
-		multisigService service.MultisigService
+func TestSignMultisigTxBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	now := time.Now().UTC()
+	mockTx := &model.MultisigTx{
+		Id:         "1",
+		UnsignedTx: "000000002004000003ea010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:  2,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "1",
+				Address:      "address",
+				Signature:    "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
+			},
+		},
+		Timestamp: &now,
+	}
+	mockResult := &dto.SignBatchResponse{
+		Results: []dto.SignBatchResult{
+			{Id: "1", Tx: mockTx},
+			{Id: "2", Error: service.ErrTxNotExists.Error()},
+		},
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	req := &dto.SignBatchArgs{
+		Signatures: []dto.SignBatchItem{
+			{Id: "1", Signature: mockTx.Owners[0].Signature},
+			{Id: "2", Signature: mockTx.Owners[0].Signature},
+		},
 	}
+	reqAsJson, _ := json.Marshal(req)
+	emptyReqAsJson, _ := json.Marshal(&dto.SignBatchArgs{Signatures: []dto.SignBatchItem{}})
+
+	mockMultisigService.EXPECT().SignMultisigTxBatch(req, gomock.Any()).Return(mockResult, nil).Times(1)
 
 	tests := []struct {
-		name string
-		args args
-		want MultisigHandler
+		name     string
+		body     string
+		wantCode int
+		wantBody string
+		isError  bool
 	}{
 		{
-			name: "new multisig handler instance",
+			name:     "sign batch of multisig txs",
+			body:     string(reqAsJson),
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name:     "sign empty batch - should fail",
+			body:     string(emptyReqAsJson),
+			wantCode: http.StatusBadRequest,
+			wantBody: "Error parsing signatures from JSON",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req := &http.Request{
+				Method: "POST",
+				Header: make(http.Header),
+				Body:   io.NopCloser(bytes.NewBuffer([]byte(tt.body))),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+
+			h.SignMultisigTxBatch(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestWithdrawSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	withdrawnAt := time.Now().UTC()
+	mock := &model.MultisigTx{
+		Id:        "1",
+		Alias:     "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold: 2,
+		State:     model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "1",
+				Address:      "address",
+				WithdrawnAt:  &withdrawnAt,
+			},
+		},
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockMultisigService.EXPECT().WithdrawSignature("1", "1678877386", "signature", gomock.Any()).Return(mock, nil).Times(1)
+	mockMultisigService.EXPECT().WithdrawSignature("2", "1678877386", "signature", gomock.Any()).Return(nil, service.ErrTxNotExists).Times(1)
+	mockMultisigService.EXPECT().WithdrawSignature("3", "1678877386", "signature", gomock.Any()).Return(nil, service.ErrInvalidStateTransition).Times(1)
+	mockMultisigService.EXPECT().WithdrawSignature("1", "1678877386", "other", gomock.Any()).Return(nil, service.ErrOwnerHasNotSigned).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "withdraw signature",
 			args: args{
-				multisigService: service.NewMultisigService(nil, nil, nil),
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
 			},
-			want: &multisigHandler{
-				multisigService: service.NewMultisigService(nil, nil, nil),
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name: "withdraw signature of non existing multisig tx - should fail",
+			args: args{
+				id:    "2",
+				query: "?signature=signature&timestamp=1678877386",
 			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "withdraw signature of issued multisig tx - should fail",
+			args: args{
+				id:    "3",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusConflict,
+			wantBody: service.ErrInvalidStateTransition.Error(),
+			isError:  true,
+		},
+		{
+			name: "withdraw signature of owner who has not signed - should fail",
+			args: args{
+				id:    "1",
+				query: "?signature=other&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrOwnerHasNotSigned.Error(),
+			isError:  true,
+		},
+		{
+			name: "withdraw signature without timestamp - should fail",
+			args: args{
+				id:    "1",
+				query: "?signature=signature",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'timestamp'",
+			isError:  true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewMultisigHandler(tt.args.multisigService); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewMultisigHandler() = %v, want %v", got, tt.want)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "DELETE",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.WithdrawSignature(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestRejectMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	now := time.Now().UTC()
+	mockResult := &model.MultisigTx{
+		Id:          "1",
+		Alias:       "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:   2,
+		State:       model.MultisigTxStateRejected,
+		StateReason: "rejected by owners: address",
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "1",
+				Address:      "address",
+				Rejection:    "rejection",
+				RejectedAt:   &now,
+			},
+		},
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	req := &dto.RejectTxArgs{
+		Timestamp: "1678877386",
+		Signature: "rejection",
+	}
+	reqAsJson, _ := json.Marshal(req)
+
+	mockMultisigService.EXPECT().RejectMultisigTx(mockResult.Id, req, gomock.Any()).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().RejectMultisigTx("2", req, gomock.Any()).Return(nil, service.ErrTxNotExists).Times(1)
+	mockMultisigService.EXPECT().RejectMultisigTx("3", req, gomock.Any()).Return(nil, service.ErrOwnerHasSigned).Times(1)
+
+	type args struct {
+		id   string
+		body string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "reject multisig tx",
+			args: args{
+				id:   mockResult.Id,
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name: "reject non existing multisig tx - should fail",
+			args: args{
+				id:   "2",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "reject signed multisig tx - should fail",
+			args: args{
+				id:   "3",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrOwnerHasSigned.Error(),
+			isError:  true,
+		},
+		{
+			name: "reject multisig tx without signature - should fail",
+			args: args{
+				id:   mockResult.Id,
+				body: `{"timestamp":"1678877386"}`,
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Error parsing JSON for rejecting multisig transaction",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req := &http.Request{
+				Method: "POST",
+				Header: make(http.Header),
+				Body:   io.NopCloser(bytes.NewBuffer([]byte(tt.args.body))),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.RejectMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestExtendMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	now := time.Now().UTC()
+	expiration := now.Add(time.Hour * 24 * 30).Truncate(time.Second)
+	mockResult := &model.MultisigTx{
+		Id:         "1",
+		Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:  2,
+		State:      model.MultisigTxStatePending,
+		Expiration: &expiration,
+		ExtendedBy: "address",
+		ExtendedAt: &now,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "1",
+				Address:      "address",
+			},
+		},
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	req := &dto.ExtendTxArgs{
+		Expiration: expiration.Unix(),
+		Timestamp:  "1678877386",
+		Signature:  "signature",
+	}
+	reqAsJson, _ := json.Marshal(req)
+
+	mockMultisigService.EXPECT().ExtendMultisigTx(mockResult.Id, req, gomock.Any()).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().ExtendMultisigTx("2", req, gomock.Any()).Return(nil, service.ErrTxNotExists).Times(1)
+	mockMultisigService.EXPECT().ExtendMultisigTx("3", req, gomock.Any()).Return(nil, service.ErrTxNotPending).Times(1)
+	mockMultisigService.EXPECT().ExtendMultisigTx("4", req, gomock.Any()).Return(nil, service.ErrExpirationTooLate).Times(1)
+
+	type args struct {
+		id   string
+		body string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "extend multisig tx",
+			args: args{
+				id:   mockResult.Id,
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name: "extend non existing multisig tx - should fail",
+			args: args{
+				id:   "2",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "extend issued multisig tx - should fail",
+			args: args{
+				id:   "3",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusConflict,
+			wantBody: service.ErrTxNotPending.Error(),
+			isError:  true,
+		},
+		{
+			name: "extend beyond the maximum expiration - should fail",
+			args: args{
+				id:   "4",
+				body: string(reqAsJson),
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrExpirationTooLate.Error(),
+			isError:  true,
+		},
+		{
+			name: "extend multisig tx without expiration - should fail",
+			args: args{
+				id:   mockResult.Id,
+				body: `{"timestamp":"1678877386","signature":"signature"}`,
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Error parsing JSON for extending multisig transaction",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req := &http.Request{
+				Method: "POST",
+				Header: make(http.Header),
+				Body:   io.NopCloser(bytes.NewBuffer([]byte(tt.args.body))),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.ExtendMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestCancelMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	now := time.Now().UTC()
+	mockVotedTx := &model.MultisigTx{
+		Id:        "2",
+		Alias:     "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold: 2,
+		State:     model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId:  "2",
+				Address:       "address",
+				CancelVotedAt: &now,
+			},
+		},
+	}
+	votedTxAsJson, _ := json.Marshal(mockVotedTx)
+
+	cancelArgs := func(id string) *dto.CancelTxArgs {
+		return &dto.CancelTxArgs{
+			Id:        id,
+			Timestamp: "1678877386",
+			Signature: "signature",
+			Reason:    "wrong amount",
+		}
+	}
+	cancelArgsAsJson := func(id string) string {
+		b, _ := json.Marshal(cancelArgs(id))
+		return string(b)
+	}
+
+	mockMultisigService.EXPECT().CancelMultisigTx(cancelArgs("1"), gomock.Any()).Return(&model.MultisigTx{Id: "1", State: model.MultisigTxStateCancelled}, nil).Times(1)
+	mockMultisigService.EXPECT().CancelMultisigTx(cancelArgs("2"), gomock.Any()).Return(mockVotedTx, nil).Times(1)
+	mockMultisigService.EXPECT().CancelMultisigTx(cancelArgs("3"), gomock.Any()).Return(nil, service.ErrNotCreator).Times(1)
+	mockMultisigService.EXPECT().CancelMultisigTx(cancelArgs("4"), gomock.Any()).Return(nil, service.ErrOwnerHasVotedToCancel).Times(1)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "cancel multisig tx",
+			body:     cancelArgsAsJson("1"),
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:     "vote to cancel multisig tx",
+			body:     cancelArgsAsJson("2"),
+			wantCode: http.StatusAccepted,
+			wantBody: string(votedTxAsJson),
+		},
+		{
+			name:     "cancel multisig tx of another creator - should fail",
+			body:     cancelArgsAsJson("3"),
+			wantCode: http.StatusForbidden,
+			wantBody: service.ErrNotCreator.Error(),
+		},
+		{
+			name:     "vote to cancel multisig tx twice - should fail",
+			body:     cancelArgsAsJson("4"),
+			wantCode: http.StatusConflict,
+			wantBody: service.ErrOwnerHasVotedToCancel.Error(),
+		},
+		{
+			name:     "cancel multisig tx with a too long reason - should fail",
+			body:     `{"id":"5","timestamp":"1678877386","signature":"signature","reason":"` + strings.Repeat("a", 1025) + `"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: "Error parsing JSON for canceling multisig transaction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req := &http.Request{
+				Method: "POST",
+				Header: make(http.Header),
+				Body:   io.NopCloser(bytes.NewBuffer([]byte(tt.body))),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+
+			h.CancelMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, c.Writer.Status())
+			assert.Contains(t, w.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestGetSignedMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	mockResult := &dto.SignedTxResponse{
+		SignedTx: "000000002007000003ea",
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	mockMultisigService.EXPECT().GetSignedMultisigTx("1", "1678877386", "signature").Return(mockResult.SignedTx, nil).Times(1)
+	mockMultisigService.EXPECT().GetSignedMultisigTx("2", "1678877386", "signature").Return("", service.ErrThresholdNotReached).Times(1)
+	mockMultisigService.EXPECT().GetSignedMultisigTx("3", "1678877386", "signature").Return("", service.ErrTxNotExists).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get signed multisig tx",
+			args: args{
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name: "get signed multisig tx below threshold - should fail",
+			args: args{
+				id:    "2",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrThresholdNotReached.Error(),
+			isError:  true,
+		},
+		{
+			name: "get signed multisig tx for non existing id - should fail",
+			args: args{
+				id:    "3",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "get signed multisig tx without signature - should fail",
+			args: args{
+				id:    "1",
+				query: "?timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "alias",
+					Value: tt.args.id,
+				},
+			}
+
+			h.GetSignedMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetMultisigTxHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	mockResult := &dto.MultisigTxHistoryResponse{
+		Transactions: []model.MultisigTx{
+			{
+				Id:            "1",
+				Alias:         alias,
+				TransactionId: "transactionId",
+				State:         model.MultisigTxStateCommitted,
+				Issuer:        "address",
+			},
+		},
+		Total:  1,
+		Limit:  10,
+		Offset: 0,
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	mockMultisigService.EXPECT().GetMultisigTxHistory(alias, "1678877386", "signature", 10, 0).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().GetMultisigTxHistory(alias, "1678877386", "invalid", 0, 0).Return(nil, service.ErrParsingSignature).Times(1)
+
+	type args struct {
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get multisig tx history",
+			args: args{
+				query: "?signature=signature&timestamp=1678877386&limit=10",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name: "get multisig tx history with invalid signature - should fail",
+			args: args{
+				query: "?signature=invalid&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrParsingSignature.Error(),
+			isError:  true,
+		},
+		{
+			name: "get multisig tx history with invalid limit - should fail",
+			args: args{
+				query: "?signature=signature&timestamp=1678877386&limit=ten",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Invalid query parameter 'limit'",
+			isError:  true,
+		},
+		{
+			name: "get multisig tx history without timestamp - should fail",
+			args: args{
+				query: "?signature=signature",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'timestamp'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "alias",
+					Value: alias,
+				},
+			}
+
+			h.GetMultisigTxHistory(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	mockResult := &dto.AuditEventsResponse{
+		Events: []model.AuditEvent{
+			{
+				Id:           1,
+				Action:       model.AuditActionCreate,
+				MultisigTxId: "1",
+				Alias:        alias,
+				Actor:        "address",
+				Signature:    "signature",
+				RequestId:    "request_id",
+				ClientIp:     "127.0.0.1",
+				PayloadHash:  "payload_hash",
+			},
+		},
+		Total:  1,
+		Limit:  10,
+		Offset: 0,
+	}
+	resultAsJson, _ := json.Marshal(mockResult)
+
+	mockMultisigService.EXPECT().GetAuditEvents(alias, "1678877386", "signature", 10, 0).Return(mockResult, nil).Times(1)
+	mockMultisigService.EXPECT().GetAuditEvents(alias, "1678877386", "invalid", 0, 0).Return(nil, service.ErrAddressNotOwner).Times(1)
+
+	type args struct {
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get audit events",
+			args: args{
+				query: "?signature=signature&timestamp=1678877386&limit=10",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(resultAsJson),
+			isError:  false,
+		},
+		{
+			name: "get audit events signed by a non owner - should fail",
+			args: args{
+				query: "?signature=invalid&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrAddressNotOwner.Error(),
+			isError:  true,
+		},
+		{
+			name: "get audit events with invalid offset - should fail",
+			args: args{
+				query: "?signature=signature&timestamp=1678877386&offset=ten",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Invalid query parameter 'offset'",
+			isError:  true,
+		},
+		{
+			name: "get audit events without signature - should fail",
+			args: args{
+				query: "?timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "alias",
+					Value: alias,
+				},
+			}
+
+			h.GetAuditEvents(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	mock := &model.MultisigTx{
+		Id:        "1",
+		Alias:     "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold: 2,
+		State:     model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "1",
+				Address:      "address",
+				Signature:    "signature",
+			},
+		},
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockMultisigService.EXPECT().GetMultisigTxForOwner("1", "1678877386", "signature").Return(mock, nil).Times(1)
+	mockMultisigService.EXPECT().GetMultisigTxForOwner("2", "1678877386", "signature").Return(nil, service.ErrTxNotExists).Times(1)
+	mockMultisigService.EXPECT().GetMultisigTxForOwner("1", "1678877386", "other").Return(nil, service.ErrAddressNotOwner).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get multisig tx",
+			args: args{
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name: "get non existing multisig tx - should fail",
+			args: args{
+				id:    "2",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "get multisig tx as non owner - should fail",
+			args: args{
+				id:    "1",
+				query: "?signature=other&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrAddressNotOwner.Error(),
+			isError:  true,
+		},
+		{
+			name: "get multisig tx without signature - should fail",
+			args: args{
+				id:    "1",
+				query: "?timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.GetMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetDecodedMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	mock := &model.DecodedTx{
+		Type:      "BaseTx",
+		NetworkId: 1002,
+		Inputs: []model.DecodedInput{
+			{
+				TxId:    "txId",
+				AssetId: "assetId",
+				Amount:  1000,
+			},
+		},
+		Outputs: []model.DecodedOutput{
+			{
+				AssetId:   "assetId",
+				Amount:    900,
+				Threshold: 1,
+				Addresses: []string{"address"},
+			},
+		},
+		Amounts: []model.DecodedAmount{
+			{
+				AssetId:  "assetId",
+				Consumed: 1000,
+				Produced: 900,
+				Burned:   100,
+			},
+		},
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockMultisigService.EXPECT().GetDecodedMultisigTx("1", "1678877386", "signature").Return(mock, nil).Times(1)
+	mockMultisigService.EXPECT().GetDecodedMultisigTx("2", "1678877386", "signature").Return(nil, service.ErrTxNotExists).Times(1)
+	mockMultisigService.EXPECT().GetDecodedMultisigTx("1", "1678877386", "other").Return(nil, service.ErrAddressNotOwner).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get decoded multisig tx",
+			args: args{
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name: "decode non existing multisig tx - should fail",
+			args: args{
+				id:    "2",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "decode multisig tx as non owner - should fail",
+			args: args{
+				id:    "1",
+				query: "?signature=other&timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrAddressNotOwner.Error(),
+			isError:  true,
+		},
+		{
+			name: "decode multisig tx without signature - should fail",
+			args: args{
+				id:    "1",
+				query: "?timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.GetDecodedMultisigTx(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestNewMultisigHandler(t *testing.T) {
+	type args struct {
+		multisigService service.MultisigService
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want MultisigHandler
+	}{
+		{
+			name: "new multisig handler instance",
+			args: args{
+				multisigService: service.NewMultisigService(nil, nil, nil, nil, nil),
+			},
+			want: &multisigHandler{
+				multisigService: service.NewMultisigService(nil, nil, nil, nil, nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMultisigHandler(tt.args.multisigService); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewMultisigHandler() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetChildMultisigTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	mock := &[]model.MultisigTx{
+		{
+			Id:                "2",
+			Alias:             "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+			Threshold:         2,
+			ParentTransaction: "1",
+			State:             model.MultisigTxStatePending,
+			Owners: []model.MultisigTxOwner{
+				{
+					MultisigTxId: "2",
+					Address:      "address",
+				},
+			},
+		},
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockMultisigService.EXPECT().GetChildMultisigTxs("1", "1678877386", "signature").Return(mock, nil).Times(1)
+	mockMultisigService.EXPECT().GetChildMultisigTxs("3", "1678877386", "signature").Return(nil, service.ErrTxNotExists).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get children of multisig tx",
+			args: args{
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name: "get children of non existing multisig tx - should fail",
+			args: args{
+				id:    "3",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "get children of multisig tx without timestamp - should fail",
+			args: args{
+				id:    "1",
+				query: "?signature=signature",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'timestamp'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.GetChildMultisigTxs(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestGetSignatureProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMultisigService := service.NewMockMultisigService(ctrl)
+	h := NewMultisigHandler(mockMultisigService)
+
+	mock := &dto.LedgerProof{
+		MultisigTxId: "1",
+		Head:         "hash",
+		Steps: []dto.LedgerProofStep{
+			{
+				Id:          1,
+				PrevHash:    model.LedgerGenesisHash,
+				ContentHash: "contentHash",
+				Hash:        "hash",
+				Entry: &model.LedgerEntry{
+					Id:        1,
+					Operation: model.LedgerOperationSign,
+					Table:     model.LedgerTableMultisigTxOwners,
+					RecordId:  "1",
+					Address:   "address",
+					Signature: "signature",
+					PrevHash:  model.LedgerGenesisHash,
+					Hash:      "hash",
+				},
+			},
+		},
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockMultisigService.EXPECT().GetSignatureProof("1", "1678877386", "signature").Return(mock, nil).Times(1)
+	mockMultisigService.EXPECT().GetSignatureProof("3", "1678877386", "signature").Return(nil, service.ErrTxNotExists).Times(1)
+
+	type args struct {
+		id    string
+		query string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name: "get signature proof of multisig tx",
+			args: args{
+				id:    "1",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name: "get signature proof of non existing multisig tx - should fail",
+			args: args{
+				id:    "3",
+				query: "?signature=signature&timestamp=1678877386",
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrTxNotExists.Error(),
+			isError:  true,
+		},
+		{
+			name: "get signature proof without signature - should fail",
+			args: args{
+				id:    "1",
+				query: "?timestamp=1678877386",
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: "Missing query parameter 'signature'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.args.query)
+			req := &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+			req.Header.Add("Accept", "application/json")
+			c.Request = req
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.args.id,
+				},
+			}
+
+			h.GetSignatureProof(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
 			}
 		})
 	}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	requestIdHeader = "X-Request-Id"
+	requestIdKey    = "requestId"
+	// maxRequestIdLength is the size of the request_id column of the audit events
+	maxRequestIdLength = 64
+)
+
+// RequestId takes the request id from the X-Request-Id header, or generates one if it is missing or invalid,
+// and echoes it back in the response so that audit events can be correlated with client logs
+func RequestId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestId := ctx.GetHeader(requestIdHeader)
+		if !isValidRequestId(requestId) {
+			b := make([]byte, 16)
+			if _, err := rand.Read(b); err == nil {
+				requestId = hex.EncodeToString(b)
+			}
+		}
+		ctx.Set(requestIdKey, requestId)
+		ctx.Header(requestIdHeader, requestId)
+		ctx.Next()
+	}
+}
+
+// isValidRequestId returns whether the request id is not empty, fits into the audit events and consists of
+// printable ascii characters only
+func isValidRequestId(requestId string) bool {
+	if requestId == "" || len(requestId) > maxRequestIdLength {
+		return false
+	}
+	for i := 0; i < len(requestId); i++ {
+		if requestId[i] < ' ' || requestId[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func requestInfo(ctx *gin.Context) *model.RequestInfo {
+	return &model.RequestInfo{
+		RequestId: ctx.GetString(requestIdKey),
+		ClientIp:  ctx.ClientIP(),
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestId(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestId     string
+		wantGenerated bool
+	}{
+		{
+			name:      "request id from header",
+			requestId: "request_id",
+		},
+		{
+			name:          "generated request id",
+			requestId:     "",
+			wantGenerated: true,
+		},
+		{
+			name:          "oversized request id",
+			requestId:     strings.Repeat("a", maxRequestIdLength+1),
+			wantGenerated: true,
+		},
+		{
+			name:          "unprintable request id",
+			requestId:     "request\x01id",
+			wantGenerated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info *model.RequestInfo
+			router := gin.New()
+			router.Use(RequestId())
+			router.GET("/", func(ctx *gin.Context) {
+				info = requestInfo(ctx)
+				ctx.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.requestId != "" {
+				req.Header.Set(requestIdHeader, tt.requestId)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.NotEmpty(t, info.RequestId)
+			if tt.wantGenerated {
+				assert.NotEqual(t, tt.requestId, info.RequestId)
+				assert.Len(t, info.RequestId, 32)
+			} else {
+				assert.Equal(t, tt.requestId, info.RequestId)
+			}
+			assert.Equal(t, info.RequestId, w.Header().Get(requestIdHeader))
+			assert.Equal(t, "192.0.2.1", info.ClientIp)
+		})
+	}
+}
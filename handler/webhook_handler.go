@@ -0,0 +1,213 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler interface {
+	CreateSubscription(ctx *gin.Context)
+	GetSubscriptions(ctx *gin.Context)
+	DeleteSubscription(ctx *gin.Context)
+	GetDeadLetters(ctx *gin.Context)
+	RedeliverDeadLetter(ctx *gin.Context)
+}
+
+type webhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) WebhookHandler {
+	return &webhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateSubscription godoc
+// @Summary Subscribes a url to the lifecycle events of the multisig transactions of an alias, or of all aliases
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param X-Api-Key header string true "Api key of the webhook endpoints"
+// @Param webhookSubscriptionArgs body dto.WebhookSubscriptionArgs true "The url and the events to subscribe to"
+// @Success 201 {object} model.WebhookSubscription
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 401 {object} dto.SignavaultError
+// @ID CreateSubscription
+// @Router /webhooks [post]
+func (h *webhookHandler) CreateSubscription(ctx *gin.Context) {
+	var args *dto.WebhookSubscriptionArgs
+	err := ctx.BindJSON(&args)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error parsing webhook subscription args",
+				Error:   err.Error(),
+			})
+		return
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(args)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error creating webhook subscription",
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusCreated, subscription)
+}
+
+// GetSubscriptions godoc
+// @Summary Retrieves all webhook subscriptions, without their secrets
+// @Tags Webhook
+// @Param X-Api-Key header string true "Api key of the webhook endpoints"
+// @Produce  json
+// @Success 200 {array} model.WebhookSubscription
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 401 {object} dto.SignavaultError
+// @ID GetSubscriptions
+// @Router /webhooks [get]
+func (h *webhookHandler) GetSubscriptions(ctx *gin.Context) {
+	subscriptions, err := h.webhookService.GetSubscriptions()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error getting webhook subscriptions",
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, subscriptions)
+}
+
+// DeleteSubscription godoc
+// @Summary Deletes a webhook subscription together with its pending deliveries and dead letters
+// @Tags Webhook
+// @Param X-Api-Key header string true "Api key of the webhook endpoints"
+// @Param id path int true "Id of the subscription"
+// @Success 204
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 401 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID DeleteSubscription
+// @Router /webhooks/{id} [delete]
+func (h *webhookHandler) DeleteSubscription(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		h.throwInvalidPathParamError(ctx, "id", err)
+		return
+	}
+
+	err = h.webhookService.DeleteSubscription(id)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrWebhookSubscriptionNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error deleting webhook subscription with id %d", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetDeadLetters godoc
+// @Summary Retrieves the webhook deliveries which have failed too often, newest first
+// @Tags Webhook
+// @Param X-Api-Key header string true "Api key of the webhook endpoints"
+// @Param limit query int false "Maximum number of dead letters to return"
+// @Param offset query int false "Number of dead letters to skip"
+// @Produce  json
+// @Success 200 {object} dto.WebhookDeadLettersResponse
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 401 {object} dto.SignavaultError
+// @ID GetDeadLetters
+// @Router /webhooks/dead-letters [get]
+func (h *webhookHandler) GetDeadLetters(ctx *gin.Context) {
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "limit", err)
+		return
+	}
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil {
+		h.throwInvalidQueryParamError(ctx, "offset", err)
+		return
+	}
+
+	deadLetters, err := h.webhookService.GetDeadLetters(limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest,
+			&dto.SignavaultError{
+				Message: "Error getting webhook dead letters",
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.JSON(http.StatusOK, deadLetters)
+}
+
+// RedeliverDeadLetter godoc
+// @Summary Queues a dead letter for delivery again, starting over with the retries
+// @Tags Webhook
+// @Param X-Api-Key header string true "Api key of the webhook endpoints"
+// @Param id path int true "Id of the dead letter"
+// @Success 202
+// @Failure 400 {object} dto.SignavaultError
+// @Failure 401 {object} dto.SignavaultError
+// @Failure 404 {object} dto.SignavaultError
+// @ID RedeliverDeadLetter
+// @Router /webhooks/dead-letters/{id}/redeliver [post]
+func (h *webhookHandler) RedeliverDeadLetter(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		h.throwInvalidPathParamError(ctx, "id", err)
+		return
+	}
+
+	err = h.webhookService.RedeliverDeadLetter(id)
+	if err != nil {
+		code := http.StatusBadRequest
+		if err == service.ErrDeadLetterNotExists {
+			code = http.StatusNotFound
+		}
+		ctx.JSON(code,
+			&dto.SignavaultError{
+				Message: fmt.Sprintf("Error redelivering webhook dead letter with id %d", id),
+				Error:   err.Error(),
+			})
+		return
+	}
+	ctx.Status(http.StatusAccepted)
+}
+
+func (h *webhookHandler) throwInvalidPathParamError(ctx *gin.Context, param string, err error) {
+	ctx.JSON(http.StatusBadRequest,
+		&dto.SignavaultError{
+			Message: fmt.Sprintf("Invalid path parameter '%s'", param),
+			Error:   err.Error(),
+		})
+}
+
+func (h *webhookHandler) throwInvalidQueryParamError(ctx *gin.Context, param string, err error) {
+	ctx.JSON(http.StatusBadRequest,
+		&dto.SignavaultError{
+			Message: fmt.Sprintf("Invalid query parameter '%s'", param),
+			Error:   err.Error(),
+		})
+}
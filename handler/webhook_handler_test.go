@@ -0,0 +1,262 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/service"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookService := service.NewMockWebhookService(ctrl)
+	h := NewWebhookHandler(mockWebhookService)
+
+	validArgs := &dto.WebhookSubscriptionArgs{
+		Url:    "https://example.com/webhook",
+		Events: []model.MultisigTxEventType{model.MultisigTxEventSigned},
+	}
+	invalidArgs := &dto.WebhookSubscriptionArgs{
+		Url: "ftp://example.com/webhook",
+	}
+	mock := &model.WebhookSubscription{
+		Id:     1,
+		Url:    validArgs.Url,
+		Secret: "secret",
+		Events: validArgs.Events,
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockWebhookService.EXPECT().CreateSubscription(validArgs).Return(mock, nil).Times(1)
+	mockWebhookService.EXPECT().CreateSubscription(invalidArgs).Return(nil, service.ErrInvalidWebhookUrl).Times(1)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name:     "create subscription",
+			body:     `{"url":"https://example.com/webhook","events":["signed"]}`,
+			wantCode: http.StatusCreated,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name:     "create subscription with invalid url - should fail",
+			body:     `{"url":"ftp://example.com/webhook"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: service.ErrInvalidWebhookUrl.Error(),
+			isError:  true,
+		},
+		{
+			name:     "create subscription without url - should fail",
+			body:     `{"alias":"P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"}`,
+			wantCode: http.StatusBadRequest,
+			wantBody: "Error parsing webhook subscription args",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+
+			h.CreateSubscription(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookService := service.NewMockWebhookService(ctrl)
+	h := NewWebhookHandler(mockWebhookService)
+
+	mockWebhookService.EXPECT().DeleteSubscription(int64(1)).Return(nil).Times(1)
+	mockWebhookService.EXPECT().DeleteSubscription(int64(2)).Return(service.ErrWebhookSubscriptionNotExists).Times(1)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "delete subscription",
+			id:       "1",
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:     "delete non existing subscription - should fail",
+			id:       "2",
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrWebhookSubscriptionNotExists.Error(),
+		},
+		{
+			name:     "delete subscription with invalid id - should fail",
+			id:       "one",
+			wantCode: http.StatusBadRequest,
+			wantBody: "Invalid path parameter 'id'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodDelete, "/webhooks/"+tt.id, nil)
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			h.DeleteSubscription(c)
+
+			assert.Equal(t, tt.wantCode, c.Writer.Status())
+			assert.Contains(t, w.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func TestGetDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookService := service.NewMockWebhookService(ctrl)
+	h := NewWebhookHandler(mockWebhookService)
+
+	mock := &dto.WebhookDeadLettersResponse{
+		DeadLetters: []model.WebhookDeadLetter{
+			{
+				Id:             1,
+				SubscriptionId: 1,
+				EventType:      model.MultisigTxEventSigned,
+				Payload:        `{"type":"signed"}`,
+				Attempts:       8,
+				LastError:      "webhook responded with status 500",
+			},
+		},
+		Total:  1,
+		Limit:  50,
+		Offset: 0,
+	}
+	mockAsJson, _ := json.Marshal(mock)
+
+	mockWebhookService.EXPECT().GetDeadLetters(0, 0).Return(mock, nil).Times(1)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		wantBody string
+		isError  bool
+	}{
+		{
+			name:     "get dead letters",
+			query:    "",
+			wantCode: http.StatusOK,
+			wantBody: string(mockAsJson),
+			isError:  false,
+		},
+		{
+			name:     "get dead letters with invalid limit - should fail",
+			query:    "?limit=all",
+			wantCode: http.StatusBadRequest,
+			wantBody: "Invalid query parameter 'limit'",
+			isError:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			urlQuery, _ := url.Parse(tt.query)
+			c.Request = &http.Request{
+				Method: "GET",
+				URL:    urlQuery,
+				Header: make(http.Header),
+			}
+
+			h.GetDeadLetters(c)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if !tt.isError {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				// check if the error message is in the response
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestRedeliverDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockWebhookService := service.NewMockWebhookService(ctrl)
+	h := NewWebhookHandler(mockWebhookService)
+
+	mockWebhookService.EXPECT().RedeliverDeadLetter(int64(1)).Return(nil).Times(1)
+	mockWebhookService.EXPECT().RedeliverDeadLetter(int64(2)).Return(service.ErrDeadLetterNotExists).Times(1)
+
+	tests := []struct {
+		name     string
+		id       string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "redeliver dead letter",
+			id:       "1",
+			wantCode: http.StatusAccepted,
+		},
+		{
+			name:     "redeliver non existing dead letter - should fail",
+			id:       "2",
+			wantCode: http.StatusNotFound,
+			wantBody: service.ErrDeadLetterNotExists.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/webhooks/dead-letters/"+tt.id+"/redeliver", nil)
+			c.Params = gin.Params{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			h.RedeliverDeadLetter(c)
+
+			assert.Equal(t, tt.wantCode, c.Writer.Status())
+			assert.Contains(t, w.Body.String(), tt.wantBody)
+		})
+	}
+}
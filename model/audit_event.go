@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate           AuditAction = "create"
+	AuditActionSign             AuditAction = "sign"
+	AuditActionWithdraw         AuditAction = "withdraw"
+	AuditActionReject           AuditAction = "reject"
+	AuditActionExtend           AuditAction = "extend"
+	AuditActionIssue            AuditAction = "issue"
+	AuditActionCancelVote       AuditAction = "cancel_vote"
+	AuditActionCancel           AuditAction = "cancel"
+	AuditActionArchive          AuditAction = "archive"
+	AuditActionSignDepositOffer AuditAction = "sign_deposit_offer"
+)
+
+// RequestInfo identifies the api request which has triggered an action
+type RequestInfo struct {
+	RequestId string
+	ClientIp  string
+}
+
+// AuditEvent records who has performed an action, with the signature authorizing it and the hash of the
+// signed payload
+type AuditEvent struct {
+	Id             int64       `json:"id"`
+	Action         AuditAction `json:"action"`
+	MultisigTxId   string      `json:"multisigTxId,omitempty"`
+	Alias          string      `json:"alias,omitempty"`
+	DepositOfferId string      `json:"depositOfferId,omitempty"`
+	Actor          string      `json:"actor"`
+	Signature      string      `json:"signature,omitempty"`
+	RequestId      string      `json:"requestId,omitempty"`
+	ClientIp       string      `json:"clientIp,omitempty"`
+	PayloadHash    string      `json:"payloadHash,omitempty"`
+	Timestamp      *time.Time  `json:"timestamp"`
+}
+
+// WithAction returns a copy of the event for another action which has been triggered by the same request
+func (e *AuditEvent) WithAction(action AuditAction) *AuditEvent {
+	event := *e
+	event.Action = action
+	return &event
+}
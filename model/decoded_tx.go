@@ -0,0 +1,46 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+import "encoding/json"
+
+type DecodedTx struct {
+	Type         string          `json:"type"`
+	NetworkId    uint32          `json:"networkId"`
+	BlockchainId string          `json:"blockchainId"`
+	Memo         string          `json:"memo,omitempty"`
+	Inputs       []DecodedInput  `json:"inputs"`
+	Outputs      []DecodedOutput `json:"outputs"`
+	Amounts      []DecodedAmount `json:"amounts"`
+	Details      json.RawMessage `json:"details,omitempty"`
+}
+
+type DecodedInput struct {
+	TxId        string `json:"txId"`
+	OutputIndex uint32 `json:"outputIndex"`
+	AssetId     string `json:"assetId"`
+	Amount      uint64 `json:"amount,string"`
+	DepositTxId string `json:"depositTxId,omitempty"`
+	BondTxId    string `json:"bondTxId,omitempty"`
+}
+
+type DecodedOutput struct {
+	AssetId     string   `json:"assetId"`
+	Amount      uint64   `json:"amount,string"`
+	Locktime    uint64   `json:"locktime,string"`
+	Threshold   uint32   `json:"threshold"`
+	Addresses   []string `json:"addresses"`
+	DepositTxId string   `json:"depositTxId,omitempty"`
+	BondTxId    string   `json:"bondTxId,omitempty"`
+}
+
+// DecodedAmount sums up the consumed and produced amounts of an asset. The burned amount is the tx fee.
+type DecodedAmount struct {
+	AssetId  string `json:"assetId"`
+	Consumed uint64 `json:"consumed,string"`
+	Produced uint64 `json:"produced,string"`
+	Burned   uint64 `json:"burned,string"`
+}
@@ -9,6 +9,20 @@ import (
 	"time"
 )
 
+type MultisigTxState string
+
+const (
+	MultisigTxStatePending          MultisigTxState = "pending"
+	MultisigTxStateThresholdReached MultisigTxState = "threshold_reached"
+	MultisigTxStateIssued           MultisigTxState = "issued"
+	MultisigTxStateCommitted        MultisigTxState = "committed"
+	MultisigTxStateRejected         MultisigTxState = "rejected"
+	MultisigTxStateExpired          MultisigTxState = "expired"
+	MultisigTxStateStale            MultisigTxState = "stale"
+	MultisigTxStateInvalidated      MultisigTxState = "invalidated"
+	MultisigTxStateCancelled        MultisigTxState = "cancelled"
+)
+
 type MultisigTx struct {
 	Id                string            `json:"id" binding:"required"`
 	UnsignedTx        string            `json:"unsignedTx" binding:"required"`
@@ -19,13 +33,44 @@ type MultisigTx struct {
 	ParentTransaction string            `json:"parentTransaction"`
 	OutputOwners      string            `json:"outputOwners" binding:"required"`
 	Metadata          string            `json:"metadata"`
+	AutoIssue         bool              `json:"autoIssue"`
+	State             MultisigTxState   `json:"state"`
+	StateUpdatedAt    *time.Time        `json:"stateUpdatedAt,omitempty"`
+	StateReason       string            `json:"stateReason,omitempty"`
+	Creator           string            `json:"creator,omitempty"`
+	CancelledBy       string            `json:"cancelledBy,omitempty"`
+	Issuer            string            `json:"issuer,omitempty"`
 	Expiration        *time.Time        `json:"expiration,omitempty"`
+	ExtendedBy        string            `json:"extendedBy,omitempty"` // owner who has extended the expiration last
+	ExtendedAt        *time.Time        `json:"extendedAt,omitempty"`
+	IssuedAt          *time.Time        `json:"issuedAt,omitempty"`
+	TxStatus          string            `json:"txStatus,omitempty"`
+	TxStatusReason    string            `json:"txStatusReason,omitempty"`
+	TxStatusUpdatedAt *time.Time        `json:"txStatusUpdatedAt,omitempty"`
+	InputIds          []string          `json:"-"` // utxos spent by the unsigned tx
 	Owners            []MultisigTxOwner `json:"owners" binding:"required"`
+	OwnerTree         []OwnerNode       `json:"ownerTree,omitempty"` // only set if the alias has nested aliases
 	Timestamp         *time.Time        `json:"timestamp" binding:"required"`
+	ArchivedAt        *time.Time        `json:"archivedAt,omitempty"`
+	Decoded           *DecodedTx        `json:"decoded,omitempty"`
 }
 
 type MultisigTxOwner struct {
-	MultisigTxId string `json:"-" binding:"required"`
-	Address      string `json:"address" binding:"required"`
-	Signature    string `json:"signature"`
+	MultisigTxId  string     `json:"-" binding:"required"`
+	Address       string     `json:"address" binding:"required"`
+	Signature     string     `json:"signature"`
+	WithdrawnAt   *time.Time `json:"withdrawnAt,omitempty"` // set if the owner has withdrawn their signature
+	Rejection     string     `json:"rejection,omitempty"`   // signature of the rejection vote of the owner
+	RejectedAt    *time.Time `json:"rejectedAt,omitempty"`
+	CancelVotedAt *time.Time `json:"cancelVotedAt,omitempty"` // set if the owner has voted to cancel the tx
+}
+
+// OwnerNode is an owner of an alias with nested multisig aliases. Nested aliases have their own owners
+// and threshold, signer keys have neither. Satisfied tells whether the signer has signed, respectively
+// whether the threshold of the alias has been reached.
+type OwnerNode struct {
+	Address   string      `json:"address"`
+	Threshold int8        `json:"threshold,omitempty"`
+	Owners    []OwnerNode `json:"owners,omitempty"`
+	Satisfied bool        `json:"satisfied"`
 }
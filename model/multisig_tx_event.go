@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+type MultisigTxEventType string
+
+const (
+	MultisigTxEventCreated          MultisigTxEventType = "created"
+	MultisigTxEventSigned           MultisigTxEventType = "signed"
+	MultisigTxEventThresholdReached MultisigTxEventType = "threshold_reached"
+	MultisigTxEventIssued           MultisigTxEventType = "issued"
+	MultisigTxEventCommitted        MultisigTxEventType = "committed"
+	MultisigTxEventExpired          MultisigTxEventType = "expired"
+	MultisigTxEventCancelled        MultisigTxEventType = "cancelled"
+	MultisigTxEventRejected         MultisigTxEventType = "rejected"
+)
+
+// MultisigTxEventTypes are all lifecycle events of multisig txs
+var MultisigTxEventTypes = []MultisigTxEventType{
+	MultisigTxEventCreated,
+	MultisigTxEventSigned,
+	MultisigTxEventThresholdReached,
+	MultisigTxEventIssued,
+	MultisigTxEventCommitted,
+	MultisigTxEventExpired,
+	MultisigTxEventCancelled,
+	MultisigTxEventRejected,
+}
+
+// MultisigTxEvent describes a change in the lifecycle of a multisig tx. Events relayed from the outbox carry an
+// idempotency key, which stays the same if the event is delivered more than once.
+type MultisigTxEvent struct {
+	Type           MultisigTxEventType `json:"type"`
+	TxId           string              `json:"txId"`
+	Alias          string              `json:"alias"`
+	State          MultisigTxState     `json:"state"`
+	Actor          string              `json:"actor,omitempty"`
+	IdempotencyKey string              `json:"idempotencyKey,omitempty"`
+	Timestamp      time.Time           `json:"timestamp"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+// OutboxEvent is a lifecycle event which has been stored in the db transaction of the change it describes and
+// waits to be relayed to the sinks
+type OutboxEvent struct {
+	Id        int64
+	Event     MultisigTxEvent
+	Attempts  int
+	LastError string
+}
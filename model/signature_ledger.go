@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+type LedgerOperation string
+
+const (
+	// LedgerOperationSign stores or replaces the signature of an address
+	LedgerOperationSign LedgerOperation = "sign"
+	// LedgerOperationWithdraw removes the signature of an address
+	LedgerOperationWithdraw LedgerOperation = "withdraw"
+	// LedgerOperationDelete removes the signatures of all addresses of a record
+	LedgerOperationDelete LedgerOperation = "delete"
+)
+
+// the tables whose signatures are chained into the ledger
+const (
+	LedgerTableMultisigTxOwners = "multisig_tx_owners"
+	LedgerTableDepositOfferSigs = "deposit_offer_sigs"
+)
+
+// LedgerGenesisHash is the previous hash of the first entry of the ledger
+const LedgerGenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// LedgerEntry is a signature write of the hash-linked signature ledger. The record is the multisig tx or the
+// deposit offer the signature belongs to.
+type LedgerEntry struct {
+	Id        int64           `json:"id"`
+	Operation LedgerOperation `json:"operation"`
+	Table     string          `json:"table"`
+	RecordId  string          `json:"recordId"`
+	Address   string          `json:"address,omitempty"`
+	Signature string          `json:"signature,omitempty"`
+	PrevHash  string          `json:"prevHash"`
+	Hash      string          `json:"hash"`
+	Timestamp *time.Time      `json:"timestamp"`
+}
+
+// ContentHash returns the hex encoded hash of the operation, table, record, address and signature of the entry.
+// Every field is prefixed with its length, so that the fields cannot be shifted into each other.
+func (e *LedgerEntry) ContentHash() string {
+	var content []byte
+	length := make([]byte, 4)
+	for _, field := range []string{string(e.Operation), e.Table, e.RecordId, e.Address, e.Signature} {
+		binary.BigEndian.PutUint32(length, uint32(len(field)))
+		content = append(content, length...)
+		content = append(content, field...)
+	}
+	return hex.EncodeToString(hashing.ComputeHash256(content))
+}
+
+// ComputeHash returns the hash linking the entry to the previous one
+func (e *LedgerEntry) ComputeHash() (string, error) {
+	return LedgerHash(e.PrevHash, e.ContentHash())
+}
+
+// LedgerHash returns the hex encoded hash of the previous hash followed by the content hash of an entry
+func LedgerHash(prevHash string, contentHash string) (string, error) {
+	prev, err := hex.DecodeString(prevHash)
+	if err != nil {
+		return "", err
+	}
+	content, err := hex.DecodeString(contentHash)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hashing.ComputeHash256(append(prev, content...))), nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// WebhookSubscription receives the lifecycle events of the multisig txs of an alias, or of all aliases if no
+// alias is set. If no events are set, all events are delivered.
+type WebhookSubscription struct {
+	Id        int64                 `json:"id"`
+	Alias     string                `json:"alias,omitempty"`
+	Url       string                `json:"url"`
+	Secret    string                `json:"secret,omitempty"`
+	Events    []MultisigTxEventType `json:"events"`
+	CreatedAt *time.Time            `json:"createdAt"`
+}
+
+// Matches returns true if the event has to be delivered to the subscription
+func (s *WebhookSubscription) Matches(event *MultisigTxEvent) bool {
+	if s.Alias != "" && s.Alias != event.Alias {
+		return false
+	}
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, eventType := range s.Events {
+		if eventType == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is an event which has not been delivered to a subscription yet
+type WebhookDelivery struct {
+	Id             int64               `json:"id"`
+	SubscriptionId int64               `json:"subscriptionId"`
+	Url            string              `json:"url"`
+	Secret         string              `json:"-"`
+	EventType      MultisigTxEventType `json:"eventType"`
+	Payload        string              `json:"payload"`
+	Attempts       int                 `json:"attempts"`
+	NextAttemptAt  *time.Time          `json:"nextAttemptAt"`
+	LastError      string              `json:"lastError,omitempty"`
+}
+
+// WebhookDeadLetter is an event which could not be delivered to a subscription within the maximum number of
+// attempts
+type WebhookDeadLetter struct {
+	Id             int64               `json:"id"`
+	SubscriptionId int64               `json:"subscriptionId"`
+	EventType      MultisigTxEventType `json:"eventType"`
+	Payload        string              `json:"payload"`
+	Attempts       int                 `json:"attempts"`
+	LastError      string              `json:"lastError"`
+	FailedAt       *time.Time          `json:"failedAt"`
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+)
+
+const defaultAliasCheckInterval = 300 * time.Second
+
+var ErrAliasChanged = errors.New("the owners or the threshold of the alias have changed since the tx has been created")
+
+// AliasDriftChecker periodically compares the owners and the threshold stored with the active multisig txs to
+// the current alias on chain and invalidates the txs whose alias has been changed in the meantime.
+type AliasDriftChecker interface {
+	Start(ctx context.Context)
+}
+
+type aliasDriftChecker struct {
+	config      *util.Config
+	dao         dao.MultisigTxDao
+	nodeService NodeService
+}
+
+func NewAliasDriftChecker(config *util.Config, dao dao.MultisigTxDao, nodeService NodeService) AliasDriftChecker {
+	return &aliasDriftChecker{
+		config:      config,
+		dao:         dao,
+		nodeService: nodeService,
+	}
+}
+
+func (c *aliasDriftChecker) Start(ctx context.Context) {
+	interval := defaultAliasCheckInterval
+	if c.config.AliasCheckInterval > 0 {
+		interval = time.Duration(c.config.AliasCheckInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.checkActiveTxs(); err != nil {
+					log.Printf("failed to check aliases of active multisig txs: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (c *aliasDriftChecker) checkActiveTxs() error {
+	activeTxs, err := c.dao.GetActiveTx()
+	if err != nil {
+		return err
+	}
+	if activeTxs == nil {
+		return nil
+	}
+
+	// the alias and its nested aliases are only resolved once per run
+	aliases := make(map[string]*resolvedAlias)
+	for _, activeTx := range *activeTxs {
+		alias, ok := aliases[activeTx.Alias]
+		if !ok {
+			alias, err = c.resolveAlias(activeTx.Alias)
+			if err != nil {
+				log.Printf("failed to get info of alias %s: %v", activeTx.Alias, err)
+				continue
+			}
+			aliases[activeTx.Alias] = alias
+		}
+
+		// the owners are not loaded with the active txs
+		txs, err := c.dao.GetMultisigTx(activeTx.Id, "", "", true)
+		if err != nil {
+			return err
+		}
+		if txs == nil || len(*txs) == 0 {
+			continue
+		}
+		_, err = invalidateOnAliasDrift(c.dao, &(*txs)[0], alias.info, alias.ownerTree)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvedAlias is the current alias info on chain together with its resolved owner tree, which is nil if
+// none of the owners is an alias
+type resolvedAlias struct {
+	info      *model.AliasInfo
+	ownerTree []model.OwnerNode
+}
+
+func (c *aliasDriftChecker) resolveAlias(alias string) (*resolvedAlias, error) {
+	aliasInfo, err := c.nodeService.GetMultisigAlias(alias)
+	if err != nil {
+		return nil, err
+	}
+	ownerTree, err := resolveOwnerTree(c.nodeService, aliasInfo.Result.Addresses, map[string]bool{alias: true}, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &resolvedAlias{info: aliasInfo, ownerTree: ownerTree}, nil
+}
+
+// invalidateOnAliasDrift moves the tx to the invalidated state if its owners or threshold differ from the given
+// alias info and owner tree, recording what has changed. It returns true if the tx has been invalidated.
+func invalidateOnAliasDrift(d dao.MultisigTxDao, multisigTx *model.MultisigTx, aliasInfo *model.AliasInfo, ownerTree []model.OwnerNode) (bool, error) {
+	drift, err := describeAliasDrift(multisigTx, aliasInfo, ownerTree)
+	if err != nil {
+		return false, err
+	}
+	if drift == "" {
+		return false, nil
+	}
+
+	log.Printf("Alias %s of multisig tx %s has changed (%s), invalidating tx", multisigTx.Alias, multisigTx.Id, drift)
+	err = validateStateTransition(multisigTx.State, model.MultisigTxStateInvalidated)
+	if err != nil {
+		return false, err
+	}
+	// the update does nothing if the tx has been issued or cancelled in the meantime
+	updated, err := d.UpdateStateWithReason(multisigTx.Id, multisigTx.State, model.MultisigTxStateInvalidated, drift)
+	if err != nil {
+		return false, err
+	}
+	if updated {
+		multisigTx.State = model.MultisigTxStateInvalidated
+		multisigTx.StateReason = drift
+	}
+	return true, nil
+}
+
+// describeAliasDrift returns a description of the differences between the owners and threshold stored with
+// the tx and the given alias info and owner tree, or an empty string if they match. Nested aliases are
+// compared level by level, so that changes of their owners or thresholds are detected as well.
+func describeAliasDrift(multisigTx *model.MultisigTx, aliasInfo *model.AliasInfo, ownerTree []model.OwnerNode) (string, error) {
+	threshold, err := strconv.Atoi(aliasInfo.Result.Threshold)
+	if err != nil {
+		return "", ErrThresholdParsing
+	}
+
+	stored := multisigTx.OwnerTree
+	if stored == nil {
+		stored = signerNodes(topLevelOwners(multisigTx))
+	}
+	current := ownerTree
+	if current == nil {
+		current = signerNodes(aliasInfo.Result.Addresses)
+	}
+	changes := describeLevelDrift("", int(multisigTx.Threshold), threshold, stored, current)
+	return strings.Join(changes, "; "), nil
+}
+
+// describeLevelDrift compares one level of the stored and the current owner tree and recurses into the
+// nested aliases which are part of both
+func describeLevelDrift(prefix string, storedThreshold int, currentThreshold int, stored []model.OwnerNode, current []model.OwnerNode) []string {
+	storedOwners := make(map[string]bool, len(stored))
+	for _, node := range stored {
+		storedOwners[node.Address] = true
+	}
+	currentNodes := make(map[string]model.OwnerNode, len(current))
+	currentOwners := make(map[string]bool, len(current))
+	for _, node := range current {
+		currentNodes[node.Address] = node
+		currentOwners[node.Address] = true
+	}
+
+	var changes []string
+	if currentThreshold != storedThreshold {
+		changes = append(changes, fmt.Sprintf("%sthreshold changed from %d to %d", prefix, storedThreshold, currentThreshold))
+	}
+	if added := difference(currentOwners, storedOwners); len(added) > 0 {
+		changes = append(changes, prefix+"owners added: "+strings.Join(added, ", "))
+	}
+	if removed := difference(storedOwners, currentOwners); len(removed) > 0 {
+		changes = append(changes, prefix+"owners removed: "+strings.Join(removed, ", "))
+	}
+	for _, node := range stored {
+		currentNode, ok := currentNodes[node.Address]
+		if !ok {
+			continue
+		}
+		switch {
+		case len(node.Owners) == 0 && len(currentNode.Owners) > 0:
+			changes = append(changes, fmt.Sprintf("%sowner %s has become an alias", prefix, node.Address))
+		case len(node.Owners) > 0 && len(currentNode.Owners) == 0:
+			changes = append(changes, fmt.Sprintf("%sowner %s is not an alias anymore", prefix, node.Address))
+		case len(node.Owners) > 0:
+			nested := describeLevelDrift(prefix+"alias "+node.Address+": ", int(node.Threshold), int(currentNode.Threshold), node.Owners, currentNode.Owners)
+			changes = append(changes, nested...)
+		}
+	}
+	return changes
+}
+
+// difference returns the sorted addresses of a which are not in b
+func difference(a map[string]bool, b map[string]bool) []string {
+	var result []string
+	for address := range a {
+		if !b[address] {
+			result = append(result, address)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
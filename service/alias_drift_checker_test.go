@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAliasesOfActiveTxs(t *testing.T) {
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	storedTx := model.MultisigTx{
+		Id:        "1",
+		Alias:     alias,
+		Threshold: 2,
+		State:     model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{MultisigTxId: "1", Address: "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"},
+			{MultisigTxId: "1", Address: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"},
+		},
+	}
+	changedOwners := model.MultisigTx{
+		Owners: []model.MultisigTxOwner{
+			{Address: "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"},
+			{Address: "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh"},
+		},
+	}
+	nestedAlias := "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"
+	nestedOwners := model.MultisigTx{
+		Owners: []model.MultisigTxOwner{
+			{Address: "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"},
+			{Address: "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh"},
+		},
+	}
+	ownerTree := []model.OwnerNode{
+		{Address: "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"},
+		{Address: nestedAlias, Threshold: 1, Owners: []model.OwnerNode{
+			{Address: "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"},
+			{Address: "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh"},
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		ownerTree []model.OwnerNode
+		mockFn    func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService)
+		wantErr   bool
+	}{
+		{
+			name: "Unchanged alias",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 2), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "Changed threshold",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 1), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason("1", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+					"threshold changed from 2 to 1").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "Changed owners",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(changedOwners, 2), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason("1", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+					"owners added: P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh; owners removed: P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name:      "Unchanged nested alias",
+			ownerTree: ownerTree,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 2), nil).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(nestedAlias).Return(aliasInfoOf(nestedOwners, 1), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:      "Changed threshold of nested alias",
+			ownerTree: ownerTree,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 2), nil).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(nestedAlias).Return(aliasInfoOf(nestedOwners, 2), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason("1", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+					"alias P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3: threshold changed from 1 to 2").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "Owner has become an alias",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 2), nil).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(nestedAlias).Return(aliasInfoOf(nestedOwners, 1), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason("1", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+					"owner P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3 has become an alias").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "Node error",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(nil, errors.New("node error")).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "Dao error",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfoOf(storedTx, 1), nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason("1", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+					gomock.Any()).Return(false, errors.New("dao error")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNodeService := NewMockNodeService(ctrl)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+			multisigTx := storedTx
+			multisigTx.OwnerTree = tt.ownerTree
+			mockDao.EXPECT().GetActiveTx().Return(&[]model.MultisigTx{{Id: "1", Alias: alias, State: storedTx.State}}, nil).Times(1)
+			mockDao.EXPECT().GetMultisigTx("1", "", "", true).Return(&[]model.MultisigTx{multisigTx}, nil).AnyTimes()
+			tt.mockFn(mockDao, mockNodeService)
+			// all other owners are signer keys
+			mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+
+			checker := &aliasDriftChecker{
+				config:      &util.Config{},
+				dao:         mockDao,
+				nodeService: mockNodeService,
+			}
+			err := checker.checkActiveTxs()
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+// newAuditEvent returns the audit event of an action on the multisig tx, authorized by the signature of the actor
+// over the given payload
+func newAuditEvent(action model.AuditAction, multisigTx *model.MultisigTx, actor string, signature string, payload []byte, requestInfo *model.RequestInfo) *model.AuditEvent {
+	event := &model.AuditEvent{
+		Action:       action,
+		MultisigTxId: multisigTx.Id,
+		Alias:        multisigTx.Alias,
+		Actor:        actor,
+		Signature:    signature,
+		PayloadHash:  payloadHash(payload),
+	}
+	withRequestInfo(event, requestInfo)
+	return event
+}
+
+func withRequestInfo(event *model.AuditEvent, requestInfo *model.RequestInfo) {
+	if requestInfo == nil {
+		return
+	}
+	event.RequestId = requestInfo.RequestId
+	event.ClientIp = requestInfo.ClientIp
+}
+
+// payloadHash returns the hex encoded sha256 hash of the signed payload, which allows to verify the signature
+// of an audit event
+func payloadHash(payload []byte) string {
+	return fmt.Sprintf("%x", hashing.ComputeHash256(payload))
+}
+
+// GetAuditEvents returns the audit log of the multisig txs of the alias, newest first. The request has to be signed
+// by a current owner of the alias.
+func (s *multisigService) GetAuditEvents(alias string, timestamp string, signature string, limit int, offset int) (*dto.AuditEventsResponse, error) {
+	signatureArgs := alias + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	err = s.verifyAliasOwner(alias, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset = pageBounds(limit, offset)
+	events, total, err := s.dao.GetAuditEvents(alias, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get audit events for alias %s: %w", alias, err)
+	}
+
+	auditEvents := make([]model.AuditEvent, 0)
+	if events != nil {
+		auditEvents = *events
+	}
+	return &dto.AuditEventsResponse{
+		Events: auditEvents,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
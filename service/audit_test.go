@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuditEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	owner := "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	timestamp := "1678877386"
+	// signature of alias+timestamp by the owner
+	signature := "47bf8e8601badef42a1157e07862157ded68fff927bc3809d5abb0d4a7c51cad3e53979193dc7069f73fe3f7b1b9e8a5946a1bd4782a565fe126a627634943dd01"
+	// signature of alias+timestamp by P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl, which is not an owner
+	notOwnerSignature := "b13add33a046526b2cde0f8373f0d21cef19f3c8750c9adc0debfa909d3bb65f46ba00c7fdd238f78e3dd102976378edf6a83d4af9a8899a6bd3693910755a8e00"
+
+	aliasInfo := aliasInfoOf(model.MultisigTx{
+		Owners: []model.MultisigTxOwner{
+			{Address: owner},
+			{Address: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"},
+		},
+	}, 1)
+	mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfo, nil).AnyTimes()
+	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+
+	events := []model.AuditEvent{
+		{
+			Id:           2,
+			Action:       model.AuditActionSign,
+			MultisigTxId: "1",
+			Alias:        alias,
+			Actor:        owner,
+			Signature:    "signature",
+			PayloadHash:  "payload_hash",
+		},
+		{
+			Id:           1,
+			Action:       model.AuditActionCreate,
+			MultisigTxId: "1",
+			Alias:        alias,
+			Actor:        owner,
+			Signature:    "signature",
+			PayloadHash:  "payload_hash",
+		},
+	}
+	mockDao.EXPECT().GetAuditEvents(alias, defaultHistoryLimit, 0).Return(&events, 2, nil).Times(1)
+	mockDao.EXPECT().GetAuditEvents(alias, maxHistoryLimit, 10).Return(nil, 2, nil).Times(1)
+
+	type args struct {
+		signature string
+		limit     int
+		offset    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *dto.AuditEventsResponse
+		err  error
+	}{
+		{
+			name: "Get audit events with default limit",
+			args: args{
+				signature: signature,
+			},
+			want: &dto.AuditEventsResponse{
+				Events: events,
+				Total:  2,
+				Limit:  defaultHistoryLimit,
+				Offset: 0,
+			},
+		},
+		{
+			name: "Get audit events with limit above maximum",
+			args: args{
+				signature: signature,
+				limit:     1000,
+				offset:    10,
+			},
+			want: &dto.AuditEventsResponse{
+				Events: []model.AuditEvent{},
+				Total:  2,
+				Limit:  maxHistoryLimit,
+				Offset: 10,
+			},
+		},
+		{
+			name: "Get audit events signed by an address that is not an owner",
+			args: args{
+				signature: notOwnerSignature,
+			},
+			err: ErrAddressNotOwner,
+		},
+		{
+			name: "Get audit events with invalid signature",
+			args: args{
+				signature: "invalid",
+			},
+			err: ErrParsingSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetAuditEvents(alias, timestamp, tt.args.signature, tt.args.limit, tt.args.offset)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
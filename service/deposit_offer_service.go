@@ -24,7 +24,7 @@ import (
 var _ DepositOfferService = (*depositOfferService)(nil)
 
 type DepositOfferService interface {
-	AddSignatures(args *dto.AddSignatureArgs) error
+	AddSignatures(args *dto.AddSignatureArgs, requestInfo *model.RequestInfo) error
 	GetSignatures(address, timestamp, signature string, multisig bool) (*[]model.DepositOfferSig, error)
 }
 
@@ -55,7 +55,7 @@ func NewDepositOfferService(config *util.Config, dao dao.DepositOfferDao, nodeSe
 	}
 }
 
-func (s *depositOfferService) AddSignatures(args *dto.AddSignatureArgs) error {
+func (s *depositOfferService) AddSignatures(args *dto.AddSignatureArgs, requestInfo *model.RequestInfo) error {
 	if len(args.Addresses) != len(args.Signatures) {
 		return ErrAddressesSigsMismatch
 	}
@@ -86,6 +86,11 @@ func (s *depositOfferService) AddSignatures(args *dto.AddSignatureArgs) error {
 		return ErrDepositOfferNotFound
 	}
 
+	owner, err := addr_utils.Format(util.PChainAlias, constants.GetHRP(s.config.NetworkId), depositOffer.OwnerAddress.Bytes())
+	if err != nil {
+		return err
+	}
+	events := make([]*model.AuditEvent, 0, len(args.Addresses))
 	for i, a := range args.Addresses {
 		addr, err := ids.ShortFromString(a)
 		if err != nil {
@@ -99,10 +104,19 @@ func (s *depositOfferService) AddSignatures(args *dto.AddSignatureArgs) error {
 		if depositOffer.OwnerAddress != signer {
 			return ErrInvalidSignature
 		}
+		event := &model.AuditEvent{
+			Action:         model.AuditActionSignDepositOffer,
+			DepositOfferId: args.DepositOfferID,
+			Actor:          owner,
+			Signature:      args.Signatures[i],
+			PayloadHash:    payloadHash(signatureArgs),
+		}
+		withRequestInfo(event, requestInfo)
+		events = append(events, event)
 
 	}
 
-	err = s.dao.AddSignatures(args.DepositOfferID, args.Addresses, args.Signatures)
+	err = s.dao.AddSignatures(args.DepositOfferID, args.Addresses, args.Signatures, events)
 	if err != nil {
 		return err
 	}
@@ -55,8 +55,8 @@ func TestAddSignatures(t *testing.T) {
 		Signatures:     append(sigs, "f2e5662693c3307f8ed970db60e95e45ca544ffed881fa3654a0f5ca508f248e0355f06d55c63289c3d387131976064cdcb3e1ee9e93e11607d5d352c75710fa00"),
 	}
 	// first time return mock
-	mockDao.EXPECT().AddSignatures(mockSig.DepositOfferID, mockSig.Addresses, mockSig.Signatures).Return(nil).Times(1)
-	mockDao.EXPECT().AddSignatures(mockMultipleSigs.DepositOfferID, mockMultipleSigs.Addresses, mockMultipleSigs.Signatures).Return(nil).Times(1)
+	mockDao.EXPECT().AddSignatures(mockSig.DepositOfferID, mockSig.Addresses, mockSig.Signatures, gomock.Any()).Return(nil).Times(1)
+	mockDao.EXPECT().AddSignatures(mockMultipleSigs.DepositOfferID, mockMultipleSigs.Addresses, mockMultipleSigs.Signatures, gomock.Any()).Return(nil).Times(1)
 	mockNodeService.EXPECT().GetAllDepositOffers(gomock.Any()).
 		Return(&platformvm.GetAllDepositOffersReply{DepositOffers: []*platformvm.APIDepositOffer{offer}}, nil).Times(3)
 	mockNodeService.EXPECT().GetAllDepositOffers(gomock.Any()).
@@ -145,7 +145,7 @@ func TestAddSignatures(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewDepositOfferService(mockConfig, mockDao, mockNodeService)
-			err := s.AddSignatures(tt.args)
+			err := s.AddSignatures(tt.args, nil)
 			require.ErrorIs(t, err, tt.err)
 		})
 	}
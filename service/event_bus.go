@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"log"
+	"sync"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+// eventBusBufferSize is the number of events buffered per subscriber before further events are dropped
+const eventBusBufferSize = 64
+
+// EventBus passes the lifecycle events of multisig txs on to the in-process subscribers of their alias, e.g. the
+// live event streams of the api. A subscriber which does not keep up misses events instead of blocking the publisher.
+type EventBus interface {
+	EventPublisher
+	// Subscribe returns the events of the alias and a function which ends the subscription and closes the channel
+	Subscribe(alias string) (<-chan model.MultisigTxEvent, func())
+}
+
+type eventBus struct {
+	lock        sync.RWMutex
+	subscribers map[string]map[chan model.MultisigTxEvent]struct{}
+}
+
+func NewEventBus() EventBus {
+	return &eventBus{
+		subscribers: make(map[string]map[chan model.MultisigTxEvent]struct{}),
+	}
+}
+
+func (b *eventBus) Publish(event model.MultisigTxEvent) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for subscriber := range b.subscribers[event.Alias] {
+		select {
+		case subscriber <- event:
+		default:
+			log.Printf("dropped %s event of multisig tx %s for a slow subscriber of alias %s", event.Type, event.TxId, event.Alias)
+		}
+	}
+}
+
+func (b *eventBus) Subscribe(alias string) (<-chan model.MultisigTxEvent, func()) {
+	subscriber := make(chan model.MultisigTxEvent, eventBusBufferSize)
+
+	b.lock.Lock()
+	if b.subscribers[alias] == nil {
+		b.subscribers[alias] = make(map[chan model.MultisigTxEvent]struct{})
+	}
+	b.subscribers[alias][subscriber] = struct{}{}
+	b.lock.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			delete(b.subscribers[alias], subscriber)
+			if len(b.subscribers[alias]) == 0 {
+				delete(b.subscribers, alias)
+			}
+			close(subscriber)
+		})
+	}
+	return subscriber, unsubscribe
+}
+
+// SubscribeEvents returns the live lifecycle events of the multisig txs of the alias and a function which ends the
+// subscription. The request has to be signed by a current owner of the alias.
+func (s *multisigService) SubscribeEvents(alias string, timestamp string, signature string) (<-chan model.MultisigTxEvent, func(), error) {
+	signatureArgs := alias + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, nil, ErrParsingSignature
+	}
+
+	err = s.verifyAliasOwner(alias, owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events, unsubscribe := s.bus.Subscribe(alias)
+	return events, unsubscribe, nil
+}
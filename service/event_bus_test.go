@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus(t *testing.T) {
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	otherAlias := "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"
+	bus := NewEventBus()
+
+	events, unsubscribe := bus.Subscribe(alias)
+	otherEvents, unsubscribeOther := bus.Subscribe(otherAlias)
+	defer unsubscribeOther()
+
+	event := model.MultisigTxEvent{Type: model.MultisigTxEventSigned, TxId: "1", Alias: alias}
+	bus.Publish(event)
+	require.Equal(t, event, <-events)
+	require.Empty(t, otherEvents)
+
+	// a subscriber which doesn't keep up misses events instead of blocking the publisher
+	for i := 0; i < eventBusBufferSize+1; i++ {
+		bus.Publish(event)
+	}
+	require.Len(t, events, eventBusBufferSize)
+
+	unsubscribe()
+	unsubscribe()
+	for range events {
+	}
+	bus.Publish(event)
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	owner := "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	timestamp := "1678877386"
+	// signature of alias+timestamp by the owner
+	signature := "47bf8e8601badef42a1157e07862157ded68fff927bc3809d5abb0d4a7c51cad3e53979193dc7069f73fe3f7b1b9e8a5946a1bd4782a565fe126a627634943dd01"
+	// signature of alias+timestamp by P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl, which is not an owner
+	notOwnerSignature := "b13add33a046526b2cde0f8373f0d21cef19f3c8750c9adc0debfa909d3bb65f46ba00c7fdd238f78e3dd102976378edf6a83d4af9a8899a6bd3693910755a8e00"
+
+	aliasInfo := aliasInfoOf(model.MultisigTx{
+		Owners: []model.MultisigTxOwner{
+			{Address: owner},
+			{Address: "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"},
+		},
+	}, 1)
+	mockNodeService.EXPECT().GetMultisigAlias(alias).Return(aliasInfo, nil).AnyTimes()
+
+	tests := []struct {
+		name      string
+		signature string
+		err       error
+	}{
+		{
+			name:      "Subscribe as owner",
+			signature: signature,
+		},
+		{
+			name:      "Subscribe signed by an address that is not an owner",
+			signature: notOwnerSignature,
+			err:       ErrAddressNotOwner,
+		},
+		{
+			name:      "Subscribe with invalid signature",
+			signature: "invalid",
+			err:       ErrParsingSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewEventBus()
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, bus, bus)
+			events, unsubscribe, err := s.SubscribeEvents(alias, timestamp, tt.signature)
+			require.ErrorIs(t, err, tt.err)
+			if tt.err != nil {
+				require.Nil(t, events)
+				return
+			}
+			defer unsubscribe()
+
+			event := model.MultisigTxEvent{Type: model.MultisigTxEventCreated, TxId: "1", Alias: alias}
+			bus.Publish(event)
+			require.Equal(t, event, <-events)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"log"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+// EventPublisher is notified about the lifecycle events of multisig txs
+type EventPublisher interface {
+	Publish(event model.MultisigTxEvent)
+}
+
+type logEventPublisher struct{}
+
+// NewLogEventPublisher returns a publisher which only logs the events
+func NewLogEventPublisher() EventPublisher {
+	return &logEventPublisher{}
+}
+
+func (p *logEventPublisher) Publish(event model.MultisigTxEvent) {
+	log.Printf("multisig tx %s of alias %s: %s", event.TxId, event.Alias, event.Type)
+}
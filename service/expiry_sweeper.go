@@ -0,0 +1,114 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+)
+
+const defaultExpirySweepInterval = 60 * time.Second
+
+// ExpirySweeper periodically moves the active multisig txs whose expiration has passed to the expired state
+// and publishes an event for each of them. If a retention period is configured, archived txs older than that
+// are deleted as well.
+type ExpirySweeper interface {
+	Start(ctx context.Context)
+}
+
+type expirySweeper struct {
+	config    *util.Config
+	dao       dao.MultisigTxDao
+	publisher EventPublisher
+}
+
+func NewExpirySweeper(config *util.Config, dao dao.MultisigTxDao, publisher EventPublisher) ExpirySweeper {
+	return &expirySweeper{
+		config:    config,
+		dao:       dao,
+		publisher: publisher,
+	}
+}
+
+func (s *expirySweeper) Start(ctx context.Context) {
+	interval := defaultExpirySweepInterval
+	if s.config.ExpirySweepInterval > 0 {
+		interval = time.Duration(s.config.ExpirySweepInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.sweepExpiredTxs(); err != nil {
+					log.Printf("failed to sweep expired multisig txs: %v", err)
+				}
+				if err := s.purgeArchivedTxs(time.Now().UTC()); err != nil {
+					log.Printf("failed to purge archived multisig txs: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (s *expirySweeper) sweepExpiredTxs() error {
+	expiredTxs, err := s.dao.GetExpiredTx()
+	if err != nil {
+		return err
+	}
+	if expiredTxs == nil {
+		return nil
+	}
+
+	for _, tx := range *expiredTxs {
+		err = validateStateTransition(tx.State, model.MultisigTxStateExpired)
+		if err != nil {
+			log.Printf("multisig tx %s cannot be moved from %s to %s: %v", tx.Id, tx.State, model.MultisigTxStateExpired, err)
+			continue
+		}
+		// the update does nothing if the tx has been issued or cancelled in the meantime
+		updated, err := s.dao.UpdateState(tx.Id, tx.State, model.MultisigTxStateExpired)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			continue
+		}
+		s.publisher.Publish(model.MultisigTxEvent{
+			Type:      model.MultisigTxEventExpired,
+			TxId:      tx.Id,
+			Alias:     tx.Alias,
+			State:     model.MultisigTxStateExpired,
+			Timestamp: time.Now().UTC(),
+		})
+	}
+	return nil
+}
+
+// purgeArchivedTxs deletes the txs which have been archived longer than the retention period. Archived txs
+// are kept forever if no retention period is configured.
+func (s *expirySweeper) purgeArchivedTxs(now time.Time) error {
+	if s.config.ArchiveRetention <= 0 {
+		return nil
+	}
+	purged, err := s.dao.PurgeArchivedTx(now.Add(-time.Hour * 24 * time.Duration(s.config.ArchiveRetention)))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Printf("purged %d archived multisig txs", purged)
+	}
+	return nil
+}
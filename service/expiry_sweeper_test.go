@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingEventPublisher struct {
+	events []model.MultisigTxEvent
+}
+
+func (p *recordingEventPublisher) Publish(event model.MultisigTxEvent) {
+	p.events = append(p.events, event)
+}
+
+func TestSweepExpiredTxs(t *testing.T) {
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+
+	tests := []struct {
+		name       string
+		storedTx   model.MultisigTx
+		mockFn     func(mockDao *dao.MockMultisigTxDao)
+		wantEvents int
+		wantErr    bool
+	}{
+		{
+			name:     "Expire pending tx",
+			storedTx: model.MultisigTx{Id: "1", Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStatePending, model.MultisigTxStateExpired).Return(true, nil).Times(1)
+			},
+			wantEvents: 1,
+		},
+		{
+			name:     "Tx has been issued in the meantime",
+			storedTx: model.MultisigTx{Id: "1", Alias: alias, State: model.MultisigTxStateThresholdReached},
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStateThresholdReached, model.MultisigTxStateExpired).Return(false, nil).Times(1)
+			},
+			wantEvents: 0,
+		},
+		{
+			name:     "Invalid state transition",
+			storedTx: model.MultisigTx{Id: "1", Alias: alias, State: model.MultisigTxStateIssued},
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().UpdateState(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantEvents: 0,
+		},
+		{
+			name:     "Dao error",
+			storedTx: model.MultisigTx{Id: "1", Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStatePending, model.MultisigTxStateExpired).Return(false, errors.New("dao error")).Times(1)
+			},
+			wantEvents: 0,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+			mockDao.EXPECT().GetExpiredTx().Return(&[]model.MultisigTx{tt.storedTx}, nil).Times(1)
+			tt.mockFn(mockDao)
+
+			publisher := &recordingEventPublisher{}
+			sweeper := &expirySweeper{
+				config:    &util.Config{},
+				dao:       mockDao,
+				publisher: publisher,
+			}
+			err := sweeper.sweepExpiredTxs()
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Len(t, publisher.events, tt.wantEvents)
+			for _, event := range publisher.events {
+				require.Equal(t, model.MultisigTxEventExpired, event.Type)
+				require.Equal(t, tt.storedTx.Id, event.TxId)
+				require.Equal(t, alias, event.Alias)
+			}
+		})
+	}
+}
+
+func TestPurgeArchivedTxs(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name      string
+		retention int
+		mockFn    func(mockDao *dao.MockMultisigTxDao)
+	}{
+		{
+			name:      "Purge archived txs after retention period",
+			retention: 30,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().PurgeArchivedTx(now.Add(-time.Hour*24*30)).Return(int64(2), nil).Times(1)
+			},
+		},
+		{
+			name:      "Keep archived txs without retention period",
+			retention: 0,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().PurgeArchivedTx(gomock.Any()).Times(0)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			tt.mockFn(mockDao)
+
+			sweeper := &expirySweeper{
+				config:    &util.Config{ArchiveRetention: tt.retention},
+				dao:       mockDao,
+				publisher: &recordingEventPublisher{},
+			}
+			err := sweeper.purgeArchivedTxs(now)
+			require.NoError(t, err)
+		})
+	}
+}
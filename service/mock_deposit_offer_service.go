@@ -36,17 +36,17 @@ func (m *MockDepositOfferService) EXPECT() *MockDepositOfferServiceMockRecorder
 }
 
 // AddSignatures mocks base method.
-func (m *MockDepositOfferService) AddSignatures(arg0 *dto.AddSignatureArgs) error {
+func (m *MockDepositOfferService) AddSignatures(arg0 *dto.AddSignatureArgs, arg1 *model.RequestInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSignatures", arg0)
+	ret := m.ctrl.Call(m, "AddSignatures", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddSignatures indicates an expected call of AddSignatures.
-func (mr *MockDepositOfferServiceMockRecorder) AddSignatures(arg0 interface{}) *gomock.Call {
+func (mr *MockDepositOfferServiceMockRecorder) AddSignatures(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignatures", reflect.TypeOf((*MockDepositOfferService)(nil).AddSignatures), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignatures", reflect.TypeOf((*MockDepositOfferService)(nil).AddSignatures), arg0, arg1)
 }
 
 // GetSignatures mocks base method.
@@ -38,32 +38,48 @@ func (m *MockMultisigService) EXPECT() *MockMultisigServiceMockRecorder {
 }
 
 // CancelMultisigTx mocks base method.
-func (m *MockMultisigService) CancelMultisigTx(arg0 *dto.CancelTxArgs) error {
+func (m *MockMultisigService) CancelMultisigTx(arg0 *dto.CancelTxArgs, arg1 *model.RequestInfo) (*model.MultisigTx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelMultisigTx", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CancelMultisigTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CancelMultisigTx indicates an expected call of CancelMultisigTx.
-func (mr *MockMultisigServiceMockRecorder) CancelMultisigTx(arg0 interface{}) *gomock.Call {
+func (mr *MockMultisigServiceMockRecorder) CancelMultisigTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).CancelMultisigTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).CancelMultisigTx), arg0, arg1)
 }
 
 // CreateMultisigTx mocks base method.
-func (m *MockMultisigService) CreateMultisigTx(arg0 *dto.MultisigTxArgs) (*model.MultisigTx, error) {
+func (m *MockMultisigService) CreateMultisigTx(arg0 *dto.MultisigTxArgs, arg1 *model.RequestInfo) (*model.MultisigTx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMultisigTx", arg0)
+	ret := m.ctrl.Call(m, "CreateMultisigTx", arg0, arg1)
 	ret0, _ := ret[0].(*model.MultisigTx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateMultisigTx indicates an expected call of CreateMultisigTx.
-func (mr *MockMultisigServiceMockRecorder) CreateMultisigTx(arg0 interface{}) *gomock.Call {
+func (mr *MockMultisigServiceMockRecorder) CreateMultisigTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).CreateMultisigTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).CreateMultisigTx), arg0, arg1)
+}
+
+// ExtendMultisigTx mocks base method.
+func (m *MockMultisigService) ExtendMultisigTx(arg0 string, arg1 *dto.ExtendTxArgs, arg2 *model.RequestInfo) (*model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendMultisigTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendMultisigTx indicates an expected call of ExtendMultisigTx.
+func (mr *MockMultisigServiceMockRecorder) ExtendMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).ExtendMultisigTx), arg0, arg1, arg2)
 }
 
 // GetAllMultisigTxForAlias mocks base method.
@@ -81,6 +97,51 @@ func (mr *MockMultisigServiceMockRecorder) GetAllMultisigTxForAlias(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMultisigTxForAlias", reflect.TypeOf((*MockMultisigService)(nil).GetAllMultisigTxForAlias), arg0, arg1, arg2)
 }
 
+// GetAuditEvents mocks base method.
+func (m *MockMultisigService) GetAuditEvents(arg0, arg1, arg2 string, arg3, arg4 int) (*dto.AuditEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.AuditEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockMultisigServiceMockRecorder) GetAuditEvents(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockMultisigService)(nil).GetAuditEvents), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetChildMultisigTxs mocks base method.
+func (m *MockMultisigService) GetChildMultisigTxs(arg0, arg1, arg2 string) (*[]model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildMultisigTxs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*[]model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildMultisigTxs indicates an expected call of GetChildMultisigTxs.
+func (mr *MockMultisigServiceMockRecorder) GetChildMultisigTxs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildMultisigTxs", reflect.TypeOf((*MockMultisigService)(nil).GetChildMultisigTxs), arg0, arg1, arg2)
+}
+
+// GetDecodedMultisigTx mocks base method.
+func (m *MockMultisigService) GetDecodedMultisigTx(arg0, arg1, arg2 string) (*model.DecodedTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecodedMultisigTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.DecodedTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecodedMultisigTx indicates an expected call of GetDecodedMultisigTx.
+func (mr *MockMultisigServiceMockRecorder) GetDecodedMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecodedMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).GetDecodedMultisigTx), arg0, arg1, arg2)
+}
+
 // GetMultisigTx mocks base method.
 func (m *MockMultisigService) GetMultisigTx(arg0 string) (*model.MultisigTx, error) {
 	m.ctrl.T.Helper()
@@ -96,47 +157,182 @@ func (mr *MockMultisigServiceMockRecorder) GetMultisigTx(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).GetMultisigTx), arg0)
 }
 
+// GetMultisigTxForOwner mocks base method.
+func (m *MockMultisigService) GetMultisigTxForOwner(arg0, arg1, arg2 string) (*model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigTxForOwner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigTxForOwner indicates an expected call of GetMultisigTxForOwner.
+func (mr *MockMultisigServiceMockRecorder) GetMultisigTxForOwner(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigTxForOwner", reflect.TypeOf((*MockMultisigService)(nil).GetMultisigTxForOwner), arg0, arg1, arg2)
+}
+
+// GetMultisigTxHistory mocks base method.
+func (m *MockMultisigService) GetMultisigTxHistory(arg0, arg1, arg2 string, arg3, arg4 int) (*dto.MultisigTxHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigTxHistory", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*dto.MultisigTxHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigTxHistory indicates an expected call of GetMultisigTxHistory.
+func (mr *MockMultisigServiceMockRecorder) GetMultisigTxHistory(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigTxHistory", reflect.TypeOf((*MockMultisigService)(nil).GetMultisigTxHistory), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetPendingMultisigTxForOwner mocks base method.
+func (m *MockMultisigService) GetPendingMultisigTxForOwner(arg0, arg1, arg2 string) (*[]dto.PendingTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingMultisigTxForOwner", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*[]dto.PendingTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingMultisigTxForOwner indicates an expected call of GetPendingMultisigTxForOwner.
+func (mr *MockMultisigServiceMockRecorder) GetPendingMultisigTxForOwner(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingMultisigTxForOwner", reflect.TypeOf((*MockMultisigService)(nil).GetPendingMultisigTxForOwner), arg0, arg1, arg2)
+}
+
+// GetSignatureProof mocks base method.
+func (m *MockMultisigService) GetSignatureProof(arg0, arg1, arg2 string) (*dto.LedgerProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignatureProof", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dto.LedgerProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignatureProof indicates an expected call of GetSignatureProof.
+func (mr *MockMultisigServiceMockRecorder) GetSignatureProof(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureProof", reflect.TypeOf((*MockMultisigService)(nil).GetSignatureProof), arg0, arg1, arg2)
+}
+
+// GetSignedMultisigTx mocks base method.
+func (m *MockMultisigService) GetSignedMultisigTx(arg0, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignedMultisigTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignedMultisigTx indicates an expected call of GetSignedMultisigTx.
+func (mr *MockMultisigServiceMockRecorder) GetSignedMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignedMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).GetSignedMultisigTx), arg0, arg1, arg2)
+}
+
 // IssueMultisigTx mocks base method.
-func (m *MockMultisigService) IssueMultisigTx(arg0 *dto.IssueTxArgs) (ids.ID, error) {
+func (m *MockMultisigService) IssueMultisigTx(arg0 *dto.IssueTxArgs, arg1 *model.RequestInfo) (ids.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IssueMultisigTx", arg0)
+	ret := m.ctrl.Call(m, "IssueMultisigTx", arg0, arg1)
 	ret0, _ := ret[0].(ids.ID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IssueMultisigTx indicates an expected call of IssueMultisigTx.
-func (mr *MockMultisigServiceMockRecorder) IssueMultisigTx(arg0 interface{}) *gomock.Call {
+func (mr *MockMultisigServiceMockRecorder) IssueMultisigTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).IssueMultisigTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).IssueMultisigTx), arg0, arg1)
+}
+
+// RejectMultisigTx mocks base method.
+func (m *MockMultisigService) RejectMultisigTx(arg0 string, arg1 *dto.RejectTxArgs, arg2 *model.RequestInfo) (*model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectMultisigTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.MultisigTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectMultisigTx indicates an expected call of RejectMultisigTx.
+func (mr *MockMultisigServiceMockRecorder) RejectMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).RejectMultisigTx), arg0, arg1, arg2)
 }
 
 // SignMultisigTx mocks base method.
-func (m *MockMultisigService) SignMultisigTx(arg0 string, arg1 *dto.SignTxArgs) (*model.MultisigTx, error) {
+func (m *MockMultisigService) SignMultisigTx(arg0 string, arg1 *dto.SignTxArgs, arg2 *model.RequestInfo) (*model.MultisigTx, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignMultisigTx", arg0, arg1)
+	ret := m.ctrl.Call(m, "SignMultisigTx", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*model.MultisigTx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SignMultisigTx indicates an expected call of SignMultisigTx.
-func (mr *MockMultisigServiceMockRecorder) SignMultisigTx(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockMultisigServiceMockRecorder) SignMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).SignMultisigTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).SignMultisigTx), arg0, arg1, arg2)
 }
 
-// updateExpiredMultisigTx mocks base method.
-func (m *MockMultisigService) updateExpiredMultisigTx(arg0 time.Time, arg1 *model.MultisigTx) (string, error) {
+// SignMultisigTxBatch mocks base method.
+func (m *MockMultisigService) SignMultisigTxBatch(arg0 *dto.SignBatchArgs, arg1 *model.RequestInfo) (*dto.SignBatchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "updateExpiredMultisigTx", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SignMultisigTxBatch", arg0, arg1)
+	ret0, _ := ret[0].(*dto.SignBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMultisigTxBatch indicates an expected call of SignMultisigTxBatch.
+func (mr *MockMultisigServiceMockRecorder) SignMultisigTxBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMultisigTxBatch", reflect.TypeOf((*MockMultisigService)(nil).SignMultisigTxBatch), arg0, arg1)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockMultisigService) SubscribeEvents(arg0, arg1, arg2 string) (<-chan model.MultisigTxEvent, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(<-chan model.MultisigTxEvent)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockMultisigServiceMockRecorder) SubscribeEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockMultisigService)(nil).SubscribeEvents), arg0, arg1, arg2)
+}
+
+// WithdrawSignature mocks base method.
+func (m *MockMultisigService) WithdrawSignature(arg0, arg1, arg2 string, arg3 *model.RequestInfo) (*model.MultisigTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawSignature", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.MultisigTx)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// updateExpiredMultisigTx indicates an expected call of updateExpiredMultisigTx.
-func (mr *MockMultisigServiceMockRecorder) updateExpiredMultisigTx(arg0, arg1 interface{}) *gomock.Call {
+// WithdrawSignature indicates an expected call of WithdrawSignature.
+func (mr *MockMultisigServiceMockRecorder) WithdrawSignature(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawSignature", reflect.TypeOf((*MockMultisigService)(nil).WithdrawSignature), arg0, arg1, arg2, arg3)
+}
+
+// archiveMultisigTx mocks base method.
+func (m *MockMultisigService) archiveMultisigTx(arg0 time.Time, arg1 *model.MultisigTx, arg2 *model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "archiveMultisigTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// archiveMultisigTx indicates an expected call of archiveMultisigTx.
+func (mr *MockMultisigServiceMockRecorder) archiveMultisigTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "updateExpiredMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).updateExpiredMultisigTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "archiveMultisigTx", reflect.TypeOf((*MockMultisigService)(nil).archiveMultisigTx), arg0, arg1, arg2)
 }
@@ -66,6 +66,36 @@ func (mr *MockNodeServiceMockRecorder) GetMultisigAlias(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAlias", reflect.TypeOf((*MockNodeService)(nil).GetMultisigAlias), arg0)
 }
 
+// GetTxStatus mocks base method.
+func (m *MockNodeService) GetTxStatus(arg0 ids.ID) (*platformvm.GetTxStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxStatus", arg0)
+	ret0, _ := ret[0].(*platformvm.GetTxStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxStatus indicates an expected call of GetTxStatus.
+func (mr *MockNodeServiceMockRecorder) GetTxStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxStatus", reflect.TypeOf((*MockNodeService)(nil).GetTxStatus), arg0)
+}
+
+// GetUTXOIds mocks base method.
+func (m *MockNodeService) GetUTXOIds(arg0 string) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOIds", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOIds indicates an expected call of GetUTXOIds.
+func (mr *MockNodeServiceMockRecorder) GetUTXOIds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOIds", reflect.TypeOf((*MockNodeService)(nil).GetUTXOIds), arg0)
+}
+
 // IssueTx mocks base method.
 func (m *MockNodeService) IssueTx(arg0 []byte) (ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -6,10 +6,15 @@
 package service
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -20,7 +25,10 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/chain4travel/camino-signavault/dao"
 	"github.com/chain4travel/camino-signavault/dto"
 	"github.com/chain4travel/camino-signavault/model"
@@ -33,17 +41,67 @@ var (
 	ErrParsingSignature         = errors.New("failed to retrieve address from signature")
 	ErrAddressNotOwner          = errors.New("address is not an owner for this alias")
 	ErrOwnerHasSigned           = errors.New("owner has already signed this alias")
+	ErrOwnerHasNotSigned        = errors.New("owner has not signed this tx")
+	ErrOwnerHasRejected         = errors.New("owner has rejected this tx")
+	ErrNotCreator               = errors.New("only the creator of the tx can cancel it")
+	ErrOwnerHasVotedToCancel    = errors.New("owner has already voted to cancel this tx")
 	ErrThresholdParsing         = errors.New("threshold is not a number")
 	ErrParsingTx                = errors.New("error parsing signed tx")
-	ErrPendingTx                = errors.New("there is already a pending tx for this alias")
+	ErrConflictingInputs        = errors.New("the tx spends utxos which are already spent by another pending tx")
 	ErrExpired                  = errors.New("expiration date has passed")
 	ErrParsingChainId           = errors.New("error parsing chain id")
 	ErrCannotUpdateNonExpiredTx = errors.New("cannot update non-expired tx")
+	ErrThresholdNotReached      = errors.New("signature threshold has not been reached")
+	ErrMissingSignature         = errors.New("missing signature of a required owner")
+	ErrSigIndexOutOfRange       = errors.New("signature index is out of range of the alias owners")
+	ErrCredentialMismatch       = errors.New("credential signature does not match the stored owner signature")
+	ErrTxIssued                 = errors.New("multisig transaction has already been issued")
+	ErrTxNotPending             = errors.New("multisig transaction is not pending anymore")
+	ErrExpirationNotExtended    = errors.New("new expiration date is not after the current one")
+	ErrExpirationTooLate        = errors.New("new expiration date exceeds the maximum expiration")
+	ErrAddressNotSigner         = errors.New("request has not been signed by the given address")
+	ErrBatchNotStored           = errors.New("the signatures of the batch have not been stored as a tx has been changed concurrently")
+	ErrStaleTimestamp           = errors.New("timestamp of the request is not recent")
 )
 
+// ConflictingInputsError wraps ErrConflictingInputs with the ids of the txs spending the same utxos
+type ConflictingInputsError struct {
+	TxIds []string
+}
+
+func (e *ConflictingInputsError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrConflictingInputs, strings.Join(e.TxIds, ", "))
+}
+
+func (e *ConflictingInputsError) Unwrap() error {
+	return ErrConflictingInputs
+}
+
+var (
+	secpInputType = reflect.TypeOf(secp256k1fx.Input{})
+	secpSigsType  = reflect.TypeOf([][secp256k1.SignatureLen]byte{})
+)
+
+// rejectSignaturePrefix is prepended to the id and timestamp signed by a rejection vote, so that the
+// signature of another request cannot be reused as a vote
+const rejectSignaturePrefix = "reject"
+
+// extendSignaturePrefix is prepended to the id, expiration and timestamp signed by an extension request
+const extendSignaturePrefix = "extend"
+
+// cancelSignaturePrefix is prepended to the id and timestamp signed by a cancel request or vote
+const cancelSignaturePrefix = "cancel"
+
+// maxCancelTimestampAge is how far the timestamp of a cancel request may be off from now, so that a
+// captured cancel signature cannot be replayed later
+const maxCancelTimestampAge = 5 * time.Minute
+
 const (
-	defaultCacheSize      = 256
-	defaultExpirationDays = 14
+	defaultCacheSize         = 256
+	defaultExpirationDays    = 14
+	defaultMaxExpirationDays = 90
+	defaultHistoryLimit      = 50
+	maxHistoryLimit          = 100
 )
 
 // Wraps the UnsignedTx to force marshalling typeID
@@ -52,14 +110,27 @@ type codecWrapper = struct {
 }
 
 type MultisigService interface {
-	CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (*model.MultisigTx, error)
+	CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
 	GetAllMultisigTxForAlias(alias string, timestamp string, signature string) (*[]model.MultisigTx, error)
 	GetMultisigTx(id string) (*model.MultisigTx, error)
-	SignMultisigTx(id string, signer *dto.SignTxArgs) (*model.MultisigTx, error)
-	IssueMultisigTx(issueTxArgs *dto.IssueTxArgs) (ids.ID, error)
-	CancelMultisigTx(cancelTxArgs *dto.CancelTxArgs) error
+	GetMultisigTxForOwner(id string, timestamp string, signature string) (*model.MultisigTx, error)
+	GetDecodedMultisigTx(id string, timestamp string, signature string) (*model.DecodedTx, error)
+	GetChildMultisigTxs(id string, timestamp string, signature string) (*[]model.MultisigTx, error)
+	GetMultisigTxHistory(alias string, timestamp string, signature string, limit int, offset int) (*dto.MultisigTxHistoryResponse, error)
+	GetPendingMultisigTxForOwner(address string, timestamp string, signature string) (*[]dto.PendingTx, error)
+	GetSignedMultisigTx(id string, timestamp string, signature string) (string, error)
+	SignMultisigTx(id string, signer *dto.SignTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
+	SignMultisigTxBatch(signBatchArgs *dto.SignBatchArgs, requestInfo *model.RequestInfo) (*dto.SignBatchResponse, error)
+	WithdrawSignature(id string, timestamp string, signature string, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
+	RejectMultisigTx(id string, rejectTxArgs *dto.RejectTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
+	ExtendMultisigTx(id string, extendTxArgs *dto.ExtendTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
+	IssueMultisigTx(issueTxArgs *dto.IssueTxArgs, requestInfo *model.RequestInfo) (ids.ID, error)
+	CancelMultisigTx(cancelTxArgs *dto.CancelTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error)
+	GetAuditEvents(alias string, timestamp string, signature string, limit int, offset int) (*dto.AuditEventsResponse, error)
+	GetSignatureProof(id string, timestamp string, signature string) (*dto.LedgerProof, error)
+	SubscribeEvents(alias string, timestamp string, signature string) (<-chan model.MultisigTxEvent, func(), error)
 
-	updateExpiredMultisigTx(t time.Time, model *model.MultisigTx) (string, error)
+	archiveMultisigTx(now time.Time, multisigTx *model.MultisigTx, event *model.AuditEvent) error
 }
 
 type multisigService struct {
@@ -67,9 +138,11 @@ type multisigService struct {
 	secpFactory secp256k1.Factory
 	dao         dao.MultisigTxDao
 	nodeService NodeService
+	publisher   EventPublisher
+	bus         EventBus
 }
 
-func NewMultisigService(config *util.Config, dao dao.MultisigTxDao, nodeService NodeService) MultisigService {
+func NewMultisigService(config *util.Config, dao dao.MultisigTxDao, nodeService NodeService, publisher EventPublisher, bus EventBus) MultisigService {
 	return &multisigService{
 		config: config,
 		secpFactory: secp256k1.Factory{
@@ -77,24 +150,18 @@ func NewMultisigService(config *util.Config, dao dao.MultisigTxDao, nodeService
 		},
 		dao:         dao,
 		nodeService: nodeService,
+		publisher:   publisher,
+		bus:         bus,
 	}
 }
 
-func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (*model.MultisigTx, error) {
+func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
 	var err error
 
 	alias := multisigTxArgs.Alias
 	unsignedTx := multisigTxArgs.UnsignedTx
 	chainId, _ := s.getChainId(unsignedTx)
 
-	exists, err := s.dao.PendingAliasExists(alias, chainId)
-	if err != nil {
-		return nil, err
-	}
-	if exists {
-		return nil, ErrPendingTx
-	}
-
 	aliasInfo, err := s.getAliasInfo(alias)
 	if err != nil {
 		return nil, err
@@ -131,7 +198,15 @@ func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (
 	if err != nil {
 		return nil, ErrThresholdParsing
 	}
+	// owners of the alias can be aliases themselves, the signatures are given by the keys owning them
+	ownerTree, err := resolveOwnerTree(s.nodeService, aliasInfo.Result.Addresses, map[string]bool{alias: true}, 1)
+	if err != nil {
+		return nil, err
+	}
 	owners := aliasInfo.Result.Addresses
+	if ownerTree != nil {
+		owners = signerKeys(ownerTree)
+	}
 
 	if !s.isCreatorOwner(owners, creator) {
 		return nil, ErrAddressNotOwner
@@ -142,6 +217,31 @@ func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (
 		return nil, err
 	}
 
+	parentTransaction := multisigTxArgs.ParentTransaction
+	if parentTransaction != "" {
+		// a tx cannot be its own parent, even if an identical tx has been stored before
+		if parentTransaction == id {
+			return nil, ErrParentTxNotExists
+		}
+		err = s.verifyParentTx(parentTransaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// several txs of an alias can be pending at the same time as long as they do not spend the same utxos
+	inputIds, err := s.getInputIds(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	conflictingTxIds, err := s.dao.GetConflictingTxIds(inputIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflictingTxIds) > 0 {
+		return nil, &ConflictingInputsError{TxIds: conflictingTxIds}
+	}
+
 	multisigTxOwners := make([]model.MultisigTxOwner, 0)
 	for _, owner := range owners {
 		ownerSignature := ""
@@ -155,8 +255,6 @@ func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (
 		}
 		multisigTxOwners = append(multisigTxOwners, multisigTxOwner)
 	}
-	parentTransaction := multisigTxArgs.ParentTransaction
-
 	multisigTx := model.MultisigTx{
 		Id:                id,
 		Alias:             alias,
@@ -167,33 +265,56 @@ func (s *multisigService) CreateMultisigTx(multisigTxArgs *dto.MultisigTxArgs) (
 		Metadata:          metadata,
 		Expiration:        expiresAt,
 		Owners:            multisigTxOwners,
+		OwnerTree:         ownerTree,
 		ParentTransaction: parentTransaction,
+		AutoIssue:         multisigTxArgs.AutoIssue,
+		State:             model.MultisigTxStatePending,
+		Creator:           creator,
+		InputIds:          inputIds,
 	}
 
-	// if tx already exists and is expired, update it
+	createEvent := newAuditEvent(model.AuditActionCreate, &multisigTx, creator, signature, common.FromHex(unsignedTx), requestInfo)
+
+	// if an identical tx already exists and is not active anymore, archive it
 	if tx, e := s.GetMultisigTxIgnoreState(id); e == nil {
-		log.Printf("An identical expired tx (id=%s) has been found and will be archived.\n", id)
-		_, err = s.updateExpiredMultisigTx(now, tx)
+		log.Printf("An identical tx (id=%s, state=%s) has been found and will be archived.\n", id, tx.State)
+		err = s.archiveMultisigTx(now, tx, createEvent.WithAction(model.AuditActionArchive))
 		if err != nil {
 			return nil, err
 		}
 	}
-	_, err = s.dao.CreateMultisigTx(&multisigTx)
+	_, err = s.dao.CreateMultisigTx(&multisigTx, createEvent)
 	if err != nil {
 		return nil, err
 	}
-	return s.GetMultisigTx(id)
+
+	createdTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+	s.publishEvent(model.MultisigTxEventCreated, createdTx, creator)
+	s.updateThresholdState(createdTx)
+	return s.autoIssueMultisigTx(createdTx, createEvent), nil
 }
 
-func (s *multisigService) updateExpiredMultisigTx(now time.Time, multisigTx *model.MultisigTx) (string, error) {
-	if multisigTx.Expiration != nil && multisigTx.Expiration.After(now) {
-		return "", ErrCannotUpdateNonExpiredTx
+// archiveMultisigTx moves a tx that is not active anymore to the archive. Pending txs are expired first.
+func (s *multisigService) archiveMultisigTx(now time.Time, multisigTx *model.MultisigTx, event *model.AuditEvent) error {
+	switch multisigTx.State {
+	case model.MultisigTxStateIssued, model.MultisigTxStateCommitted:
+		return ErrTxIssued
+	case model.MultisigTxStatePending, model.MultisigTxStateThresholdReached:
+		if multisigTx.Expiration != nil && multisigTx.Expiration.After(now) {
+			return ErrCannotUpdateNonExpiredTx
+		}
+		err := s.transitionState(multisigTx, model.MultisigTxStateExpired)
+		if err != nil {
+			return err
+		}
+		s.publishEvent(model.MultisigTxEventExpired, multisigTx, "")
 	}
-	newId := multisigTx.Expiration.Format("2006-01-02T15:04:05") + "_" + multisigTx.Id
 
-	log.Printf("New id '%s' generated for expired tx with old id = %s", newId, multisigTx.Id)
-	// update multisig_tx id
-	return newId, s.dao.DeleteTxOwnersAndUpdateID(multisigTx.Id, newId)
+	log.Printf("Archiving tx with id = %s and state = %s", multisigTx.Id, multisigTx.State)
+	return s.dao.ArchiveTx(multisigTx.Id, event)
 }
 
 func (s *multisigService) GetAllMultisigTxForAlias(alias string, timestamp string, signature string) (*[]model.MultisigTx, error) {
@@ -212,12 +333,170 @@ func (s *multisigService) GetAllMultisigTxForAlias(alias string, timestamp strin
 		return &[]model.MultisigTx{}, nil
 	}
 
+	for i := range *tx {
+		decoded, err := s.decodeMultisigTx(&(*tx)[i])
+		if err != nil {
+			log.Printf("Decoding multisig tx %s failed: %v", (*tx)[i].Id, err)
+			continue
+		}
+		(*tx)[i].Decoded = decoded
+	}
+	for i := range *tx {
+		evaluateOwnerTree((*tx)[i].OwnerTree, storedSigners(&(*tx)[i]))
+	}
 	return tx, nil
 }
 
+func (s *multisigService) GetMultisigTxHistory(alias string, timestamp string, signature string, limit int, offset int) (*dto.MultisigTxHistoryResponse, error) {
+	signatureArgs := alias + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	limit, offset = pageBounds(limit, offset)
+	txs, total, err := s.dao.GetMultisigTxHistory(alias, owner, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tx history for alias %s: %w", alias, err)
+	}
+
+	history := make([]model.MultisigTx, 0)
+	if txs != nil {
+		history = *txs
+	}
+	// pending txs whose expiration date has passed are expired even if their state has not been updated yet
+	now := time.Now().UTC()
+	for i, tx := range history {
+		if isActiveState(tx.State) && tx.Expiration != nil && !tx.Expiration.After(now) {
+			history[i].State = model.MultisigTxStateExpired
+		}
+		evaluateOwnerTree(history[i].OwnerTree, storedSigners(&history[i]))
+	}
+
+	return &dto.MultisigTxHistoryResponse{
+		Transactions: history,
+		Total:        total,
+		Limit:        limit,
+		Offset:       offset,
+	}, nil
+}
+
+// pageBounds applies the default and maximum limit to a paginated query
+func pageBounds(limit int, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	} else if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// GetPendingMultisigTxForOwner returns the pending txs of all aliases which are waiting for the signature of the
+// given address, the ones expiring first at the top. The request has to be signed by the address itself.
+func (s *multisigService) GetPendingMultisigTxForOwner(address string, timestamp string, signature string) (*[]dto.PendingTx, error) {
+	signatureArgs := address + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+	if owner != address {
+		return nil, ErrAddressNotSigner
+	}
+
+	txs, err := s.dao.GetPendingTxForOwner(owner)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get pending txs for owner %s: %w", owner, err)
+	}
+
+	pending := make([]dto.PendingTx, 0)
+	if txs == nil {
+		return &pending, nil
+	}
+	for i := range *txs {
+		multisigTx := (*txs)[i]
+		pending = append(pending, dto.PendingTx{
+			MultisigTx: multisigTx,
+			Signatures: thresholdProgress(&multisigTx),
+		})
+	}
+	// txs without expiration are listed last
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[j].Expiration == nil {
+			return pending[i].Expiration != nil
+		}
+		return pending[i].Expiration != nil && pending[i].Expiration.Before(*pending[j].Expiration)
+	})
+	return &pending, nil
+}
+
 func (s *multisigService) GetMultisigTx(id string) (*model.MultisigTx, error) {
 	return s.getMultisigTxForState(id, true)
 }
+
+// GetMultisigTxForOwner returns the tx with the given id regardless of its state if the request has been signed
+// by one of its owners
+func (s *multisigService) GetMultisigTxForOwner(id string, timestamp string, signature string) (*model.MultisigTx, error) {
+	signatureArgs := id + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	multisigTx, err := s.GetMultisigTxIgnoreState(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner, _ := s.isOwner(multisigTx, owner)
+	if !isOwner {
+		return nil, ErrAddressNotOwner
+	}
+	return multisigTx, nil
+}
+
+func (s *multisigService) GetDecodedMultisigTx(id string, timestamp string, signature string) (*model.DecodedTx, error) {
+	multisigTx, err := s.GetMultisigTxForOwner(id, timestamp, signature)
+	if err != nil {
+		return nil, err
+	}
+	return s.decodeMultisigTx(multisigTx)
+}
+
+// GetChildMultisigTxs returns the txs referencing the tx with the given id as their parent, regardless of their
+// state, if the request has been signed by one of the owners of the parent
+func (s *multisigService) GetChildMultisigTxs(id string, timestamp string, signature string) (*[]model.MultisigTx, error) {
+	parentTx, err := s.GetMultisigTxForOwner(id, timestamp, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	childIds, err := s.dao.GetChildTxIds(parentReferences(parentTx))
+	if err != nil {
+		return nil, err
+	}
+	children := make([]model.MultisigTx, 0, len(childIds))
+	for _, childId := range childIds {
+		child, err := s.GetMultisigTxIgnoreState(childId)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, *child)
+	}
+	return &children, nil
+}
+
+func (s *multisigService) decodeMultisigTx(multisigTx *model.MultisigTx) (*model.DecodedTx, error) {
+	var unsignedTx txs.UnsignedTx
+	err := s.unmarshalTx(multisigTx.UnsignedTx, &unsignedTx)
+	if err != nil {
+		return nil, ErrParsingTx
+	}
+	return decodeUnsignedTx(unsignedTx, s.config.NetworkId)
+}
+
 func (s *multisigService) GetMultisigTxIgnoreState(id string) (*model.MultisigTx, error) {
 	return s.getMultisigTxForState(id, false)
 }
@@ -230,10 +509,36 @@ func (s *multisigService) getMultisigTxForState(id string, activeOnly bool) (*mo
 		return nil, ErrTxNotExists
 	}
 
-	return &(*tx)[0], nil
+	multisigTx := &(*tx)[0]
+	evaluateOwnerTree(multisigTx.OwnerTree, storedSigners(multisigTx))
+	return multisigTx, nil
 }
 
-func (s *multisigService) SignMultisigTx(id string, signer *dto.SignTxArgs) (*model.MultisigTx, error) {
+func (s *multisigService) GetSignedMultisigTx(id string, timestamp string, signature string) (string, error) {
+	signatureArgs := id + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return "", ErrParsingSignature
+	}
+
+	multisigTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return "", err
+	}
+
+	isOwner, _ := s.isOwner(multisigTx, owner)
+	if !isOwner {
+		return "", ErrAddressNotOwner
+	}
+
+	signedTx, err := s.assembleSignedTx(multisigTx)
+	if err != nil {
+		return "", err
+	}
+	return common.Bytes2Hex(signedTx.Bytes()), nil
+}
+
+func (s *multisigService) SignMultisigTx(id string, signer *dto.SignTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
 	multisigTx, err := s.GetMultisigTx(id)
 	if err != nil {
 		return nil, err
@@ -248,23 +553,322 @@ func (s *multisigService) SignMultisigTx(id string, signer *dto.SignTxArgs) (*mo
 		return nil, ErrParsingSignature
 	}
 
+	err = s.verifySigner(multisigTx, signerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	signEvent := newAuditEvent(model.AuditActionSign, multisigTx, signerAddr, signer.Signature, common.FromHex(multisigTx.UnsignedTx), requestInfo)
+	added, err := s.dao.AddSigner(id, signer.Signature, signerAddr, signEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tx may have been archived or signed by the same owner in the meantime
+	signedTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, ErrOwnerHasSigned
+	}
+	s.publishEvent(model.MultisigTxEventSigned, signedTx, signerAddr)
+	s.updateThresholdState(signedTx)
+	return s.autoIssueMultisigTx(signedTx, signEvent), nil
+}
+
+// SignMultisigTxBatch signs several txs at once. The signers are recovered in parallel and all valid signatures
+// are stored in a single db transaction. An invalid item does not fail the batch, its error is reported in the
+// result of the item instead.
+func (s *multisigService) SignMultisigTxBatch(signBatchArgs *dto.SignBatchArgs, requestInfo *model.RequestInfo) (*dto.SignBatchResponse, error) {
+	items := signBatchArgs.Signatures
+	results := make([]dto.SignBatchResult, len(items))
+	multisigTxs := make([]*model.MultisigTx, len(items))
+	itemErrs := make([]error, len(items))
+	for i, item := range items {
+		results[i].Id = item.Id
+		if item.Signature == "" {
+			itemErrs[i] = ErrEmptySignature
+			continue
+		}
+		multisigTxs[i], itemErrs[i] = s.GetMultisigTx(item.Id)
+	}
+
+	signerAddrs := s.recoverSigners(items, multisigTxs, itemErrs)
+
+	signers := make([]model.MultisigTxOwner, 0, len(items))
+	signerEvents := make([]*model.AuditEvent, 0, len(items))
+	signEvents := make([]*model.AuditEvent, len(items))
+	batchSigners := make(map[string]bool, len(items))
+	for i, item := range items {
+		if itemErrs[i] == nil {
+			itemErrs[i] = s.verifySigner(multisigTxs[i], signerAddrs[i])
+		}
+		// the same owner may only sign a tx once within the batch
+		if itemErrs[i] == nil && batchSigners[item.Id+signerAddrs[i]] {
+			itemErrs[i] = ErrOwnerHasSigned
+		}
+		if itemErrs[i] != nil {
+			results[i].Error = itemErrs[i].Error()
+			continue
+		}
+		batchSigners[item.Id+signerAddrs[i]] = true
+		signers = append(signers, model.MultisigTxOwner{
+			MultisigTxId: item.Id,
+			Address:      signerAddrs[i],
+			Signature:    item.Signature,
+		})
+		signEvents[i] = newAuditEvent(model.AuditActionSign, multisigTxs[i], signerAddrs[i], item.Signature, common.FromHex(multisigTxs[i].UnsignedTx), requestInfo)
+		signerEvents = append(signerEvents, signEvents[i])
+	}
+
+	if len(signers) > 0 {
+		added, err := s.dao.AddSigners(signers, signerEvents)
+		if err != nil {
+			return nil, err
+		}
+		if !added {
+			for i := range items {
+				if itemErrs[i] == nil {
+					itemErrs[i] = ErrBatchNotStored
+					results[i].Error = itemErrs[i].Error()
+				}
+			}
+		}
+	}
+	for i := range items {
+		if itemErrs[i] == nil {
+			s.publishEvent(model.MultisigTxEventSigned, multisigTxs[i], signerAddrs[i])
+		}
+	}
+
+	// a tx signed by several owners of the batch is only updated and issued once
+	signedTxs := make(map[string]*model.MultisigTx, len(signers))
+	for i, item := range items {
+		if itemErrs[i] != nil {
+			continue
+		}
+		if signedTx, ok := signedTxs[item.Id]; ok {
+			results[i].Tx = signedTx
+			continue
+		}
+		signedTx, err := s.GetMultisigTx(item.Id)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		s.updateThresholdState(signedTx)
+		signedTxs[item.Id] = s.autoIssueMultisigTx(signedTx, signEvents[i])
+		results[i].Tx = signedTxs[item.Id]
+	}
+	return &dto.SignBatchResponse{Results: results}, nil
+}
+
+// recoverSigners recovers the signer addresses of the batch items in parallel. Items which have already failed
+// are skipped, a signature which cannot be parsed is recorded in the errors of the items.
+func (s *multisigService) recoverSigners(items []dto.SignBatchItem, multisigTxs []*model.MultisigTx, itemErrs []error) []string {
+	signerAddrs := make([]string, len(items))
+	var wg sync.WaitGroup
+	for i := range items {
+		if itemErrs[i] != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			signerAddr, err := s.getAddressFromSignature(multisigTxs[i].UnsignedTx, items[i].Signature, true)
+			if err != nil {
+				itemErrs[i] = ErrParsingSignature
+				return
+			}
+			signerAddrs[i] = signerAddr
+		}(i)
+	}
+	wg.Wait()
+	return signerAddrs
+}
+
+// verifySigner checks that the alias of the tx is unchanged and that the signer is an owner who has neither
+// signed nor rejected the tx yet
+func (s *multisigService) verifySigner(multisigTx *model.MultisigTx, signerAddr string) error {
+	err := s.verifyAliasUnchanged(multisigTx)
+	if err != nil {
+		return err
+	}
+
 	isOwner, isSigner := s.isOwner(multisigTx, signerAddr)
+	if !isOwner {
+		return ErrAddressNotOwner
+	}
+	if isSigner {
+		return ErrOwnerHasSigned
+	}
+	if hasRejected(multisigTx, signerAddr) {
+		return ErrOwnerHasRejected
+	}
+	return nil
+}
+
+// WithdrawSignature removes the signature of the owner who has signed the request, as long as the tx
+// has not been issued. A tx which is below its threshold afterwards goes back to pending.
+func (s *multisigService) WithdrawSignature(id string, timestamp string, signature string, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
+	signatureArgs := id + timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	multisigTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner, isSigner := s.isOwner(multisigTx, owner)
+	if !isOwner {
+		return nil, ErrAddressNotOwner
+	}
+	if !isSigner {
+		return nil, ErrOwnerHasNotSigned
+	}
+
+	// the tx may have been issued in the meantime
+	withdrawEvent := newAuditEvent(model.AuditActionWithdraw, multisigTx, owner, signature, []byte(signatureArgs), requestInfo)
+	withdrawn, err := s.dao.WithdrawSigner(id, owner, withdrawEvent)
+	if err != nil {
+		return nil, err
+	}
+	if !withdrawn {
+		return nil, ErrInvalidStateTransition
+	}
+
+	withdrawnTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+	s.updateThresholdState(withdrawnTx)
+	return withdrawnTx, nil
+}
+
+// RejectMultisigTx stores the rejection vote of the owner who has signed the request. The tx is moved to
+// the rejected state as soon as the owners who have not rejected it cannot reach the threshold anymore.
+func (s *multisigService) RejectMultisigTx(id string, rejectTxArgs *dto.RejectTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
+	signatureArgs := rejectSignaturePrefix + id + rejectTxArgs.Timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, rejectTxArgs.Signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	multisigTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner, isSigner := s.isOwner(multisigTx, owner)
 	if !isOwner {
 		return nil, ErrAddressNotOwner
 	}
+	// a signature has to be withdrawn before rejecting the tx
 	if isSigner {
 		return nil, ErrOwnerHasSigned
 	}
+	if hasRejected(multisigTx, owner) {
+		return nil, ErrOwnerHasRejected
+	}
+
+	rejectEvent := newAuditEvent(model.AuditActionReject, multisigTx, owner, rejectTxArgs.Signature, []byte(signatureArgs), requestInfo)
+	added, err := s.dao.AddRejection(id, rejectTxArgs.Signature, owner, rejectEvent)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, ErrOwnerHasRejected
+	}
+
+	rejectedTx, err := s.GetMultisigTx(id)
+	if err != nil {
+		return nil, err
+	}
+	s.updateRejectionState(rejectedTx)
+	s.publishEvent(model.MultisigTxEventRejected, rejectedTx, owner)
+	return rejectedTx, nil
+}
+
+// ExtendMultisigTx moves the expiration of a pending tx further out, as requested by one of its owners.
+// The new expiration cannot be later than the configured maximum number of days from now.
+func (s *multisigService) ExtendMultisigTx(id string, extendTxArgs *dto.ExtendTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
+	signatureArgs := extendSignaturePrefix + id + strconv.FormatInt(extendTxArgs.Expiration, 10) + extendTxArgs.Timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, extendTxArgs.Signature, false)
+	if err != nil {
+		return nil, ErrParsingSignature
+	}
+
+	multisigTx, err := s.GetMultisigTxIgnoreState(id)
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner, _ := s.isOwner(multisigTx, owner)
+	if !isOwner {
+		return nil, ErrAddressNotOwner
+	}
+	if !isActiveState(multisigTx.State) {
+		return nil, ErrTxNotPending
+	}
+
+	now := time.Now().UTC()
+	// an expired tx which has not been archived yet cannot be revived
+	if multisigTx.Expiration != nil && !multisigTx.Expiration.After(now) {
+		return nil, ErrExpired
+	}
+	expiresAt := time.Unix(extendTxArgs.Expiration, 0).UTC()
+	if multisigTx.Expiration != nil && !expiresAt.After(*multisigTx.Expiration) {
+		return nil, ErrExpirationNotExtended
+	}
+	maxExpirationDays := s.config.TxMaxExpiration
+	// if the value is 0, use the default maximum
+	if maxExpirationDays <= 0 {
+		maxExpirationDays = defaultMaxExpirationDays
+	}
+	if expiresAt.After(now.Add(time.Hour * 24 * time.Duration(maxExpirationDays))) {
+		return nil, ErrExpirationTooLate
+	}
 
-	_, err = s.dao.AddSigner(id, signer.Signature, signerAddr)
+	// the tx may have been issued or cancelled in the meantime
+	extendEvent := newAuditEvent(model.AuditActionExtend, multisigTx, owner, extendTxArgs.Signature, []byte(signatureArgs), requestInfo)
+	updated, err := s.dao.UpdateExpirationDate(id, expiresAt, owner, extendEvent)
 	if err != nil {
 		return nil, err
 	}
+	if !updated {
+		return nil, ErrTxNotPending
+	}
 
 	return s.GetMultisigTx(id)
 }
 
-func (s *multisigService) IssueMultisigTx(sendTxArgs *dto.IssueTxArgs) (ids.ID, error) {
+// autoIssueMultisigTx issues the tx if it was created with autoIssue and its signature threshold has been reached.
+// The owner whose signature reached the threshold, i.e. the actor of the given event, is recorded as issuer.
+// A failed issuance is only logged, the signatures are kept and the tx can still be issued manually.
+func (s *multisigService) autoIssueMultisigTx(multisigTx *model.MultisigTx, event *model.AuditEvent) *model.MultisigTx {
+	if !multisigTx.AutoIssue || multisigTx.State != model.MultisigTxStateThresholdReached {
+		return multisigTx
+	}
+
+	signedTx, err := s.assembleSignedTx(multisigTx)
+	if err != nil {
+		log.Printf("Auto issuing multisig tx %s failed: %v", multisigTx.Id, err)
+		return multisigTx
+	}
+	txID, err := s.issueTx(multisigTx, signedTx.Bytes(), event.WithAction(model.AuditActionIssue))
+	if err != nil {
+		log.Printf("Auto issuing multisig tx %s failed: %v", multisigTx.Id, err)
+		return multisigTx
+	}
+	log.Printf("Multisig tx %s has been auto issued with transaction id %s", multisigTx.Id, txID)
+	return multisigTx
+}
+
+func (s *multisigService) IssueMultisigTx(sendTxArgs *dto.IssueTxArgs, requestInfo *model.RequestInfo) (ids.ID, error) {
 	var tx txs.Tx
 	err := s.unmarshalTx(sendTxArgs.SignedTx, &tx)
 	if err != nil {
@@ -290,47 +894,263 @@ func (s *multisigService) IssueMultisigTx(sendTxArgs *dto.IssueTxArgs) (ids.ID,
 		return ids.Empty, ErrAddressNotOwner
 	}
 
+	err = s.verifyAliasUnchanged(storedTx)
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	err = s.verifyCredentials(storedTx, utxHash, tx.Creds)
+	if err != nil {
+		return ids.Empty, err
+	}
+
 	signedBytes, err := txs.Codec.Marshal(txs.Version, tx)
 	if err != nil {
 		return ids.Empty, ErrParsingTx
 	}
 
+	issueEvent := newAuditEvent(model.AuditActionIssue, storedTx, signerAddr, sendTxArgs.Signature, common.FromHex(sendTxArgs.SignedTx), requestInfo)
+	return s.issueTx(storedTx, signedBytes, issueEvent)
+}
+
+// issueTx issues the signed tx and moves the multisig tx to the issued state, the actor of the event is recorded
+// as issuer. A tx with a parent can only be issued once its parent has been committed.
+func (s *multisigService) issueTx(multisigTx *model.MultisigTx, signedBytes []byte, event *model.AuditEvent) (ids.ID, error) {
+	issuer := event.Actor
+	err := validateStateTransition(multisigTx.State, model.MultisigTxStateIssued)
+	if err != nil {
+		return ids.Empty, err
+	}
+	err = s.verifyParentTxCommitted(multisigTx)
+	if err != nil {
+		return ids.Empty, err
+	}
+
 	txID, err := s.nodeService.IssueTx(signedBytes)
 	if err != nil {
 		return ids.Empty, err
 	}
-	_, err = s.dao.UpdateTransactionId(utxHashStr, txID.String())
+	updated, err := s.dao.UpdateTransactionId(multisigTx.Id, txID.String(), issuer, event)
 	if err != nil {
 		return ids.Empty, err
 	}
 
+	multisigTx.TransactionId = txID.String()
+	multisigTx.Issuer = issuer
+	multisigTx.State = model.MultisigTxStateIssued
+	// the issuance has already been recorded and published if the tx has been issued concurrently
+	if updated {
+		s.publishEvent(model.MultisigTxEventIssued, multisigTx, issuer)
+	}
 	return txID, nil
 }
 
-func (s *multisigService) CancelMultisigTx(cancelTxArgs *dto.CancelTxArgs) error {
-	owner, err := s.getAddressFromSignature(cancelTxArgs.Timestamp, cancelTxArgs.Signature, false)
+// CancelMultisigTx cancels the tx according to the configured cancel policy. With the quorum policy, the
+// request is a vote of the owner and the tx is cancelled once enough owners have voted. The returned tx
+// is still pending or threshold_reached if the quorum has not been reached yet. The owner signs
+// "cancel" + id + timestamp, and the timestamp has to be recent.
+func (s *multisigService) CancelMultisigTx(cancelTxArgs *dto.CancelTxArgs, requestInfo *model.RequestInfo) (*model.MultisigTx, error) {
+	err := verifyTimestamp(cancelTxArgs.Timestamp, maxCancelTimestampAge)
+	if err != nil {
+		return nil, err
+	}
+	signatureArgs := cancelSignaturePrefix + cancelTxArgs.Id + cancelTxArgs.Timestamp
+	owner, err := s.getAddressFromSignature(signatureArgs, cancelTxArgs.Signature, false)
 	if err != nil {
-		return ErrParsingSignature
+		return nil, ErrParsingSignature
 	}
 
 	multisigTx, err := s.GetMultisigTx(cancelTxArgs.Id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	isOwner, _ := s.isOwner(multisigTx, owner)
 	if !isOwner {
-		return ErrAddressNotOwner
+		return nil, ErrAddressNotOwner
+	}
+	err = validateStateTransition(multisigTx.State, model.MultisigTxStateCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	switch s.config.CancelPolicy {
+	case util.CancelPolicyOwner:
+	case util.CancelPolicyQuorum:
+		voteEvent := newAuditEvent(model.AuditActionCancelVote, multisigTx, owner, cancelTxArgs.Signature, []byte(signatureArgs), requestInfo)
+		added, err := s.dao.AddCancelVote(multisigTx.Id, owner, voteEvent)
+		if err != nil {
+			return nil, err
+		}
+		if !added {
+			return nil, ErrOwnerHasVotedToCancel
+		}
+		multisigTx, err = s.GetMultisigTx(cancelTxArgs.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cancelVotes(multisigTx) < s.cancelQuorum(multisigTx) {
+			return multisigTx, nil
+		}
+	default:
+		// txs created before the creator has been stored can be cancelled by any owner
+		if multisigTx.Creator != "" && multisigTx.Creator != owner {
+			return nil, ErrNotCreator
+		}
+	}
+
+	cancelEvent := newAuditEvent(model.AuditActionCancel, multisigTx, owner, cancelTxArgs.Signature, []byte(signatureArgs), requestInfo)
+	updated, err := s.dao.CancelTx(multisigTx.Id, multisigTx.State, owner, cancelTxArgs.Reason, cancelEvent)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrInvalidStateTransition
 	}
 
-	_, err = s.dao.DeletePendingTx(cancelTxArgs.Id)
+	multisigTx.State = model.MultisigTxStateCancelled
+	multisigTx.StateReason = cancelTxArgs.Reason
+	multisigTx.CancelledBy = owner
+	s.publishEvent(model.MultisigTxEventCancelled, multisigTx, owner)
+	invalidateChildTxs(s.dao, multisigTx)
+	return multisigTx, nil
+}
+
+// verifyTimestamp checks that the unix timestamp of a request is at most maxAge away from now
+func verifyTimestamp(timestamp string, maxAge time.Duration) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrStaleTimestamp
+	}
+	age := time.Since(time.Unix(seconds, 0))
+	if age > maxAge || age < -maxAge {
+		return ErrStaleTimestamp
+	}
+	return nil
+}
+
+// cancelQuorum returns the number of cancel votes needed to cancel the tx, which defaults to its threshold
+func (s *multisigService) cancelQuorum(multisigTx *model.MultisigTx) int {
+	if s.config.CancelQuorum > 0 {
+		return s.config.CancelQuorum
+	}
+	return int(multisigTx.Threshold)
+}
+
+func cancelVotes(multisigTx *model.MultisigTx) int {
+	votes := 0
+	for _, owner := range multisigTx.Owners {
+		if owner.CancelVotedAt != nil {
+			votes++
+		}
+	}
+	return votes
+}
+
+// transitionState validates and persists the transition of the tx to the given state. The update fails if
+// the stored state has been changed concurrently.
+func (s *multisigService) transitionState(multisigTx *model.MultisigTx, to model.MultisigTxState) error {
+	err := validateStateTransition(multisigTx.State, to)
+	if err != nil {
+		return err
+	}
+	updated, err := s.dao.UpdateState(multisigTx.Id, multisigTx.State, to)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrInvalidStateTransition
+	}
+
+	multisigTx.State = to
+	return nil
+}
+
+// publishEvent notifies the publisher about a lifecycle event of the tx, caused by the given actor if any
+func (s *multisigService) publishEvent(eventType model.MultisigTxEventType, multisigTx *model.MultisigTx, actor string) {
+	s.publisher.Publish(model.MultisigTxEvent{
+		Type:      eventType,
+		TxId:      multisigTx.Id,
+		Alias:     multisigTx.Alias,
+		State:     multisigTx.State,
+		Actor:     actor,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// transitionStateWithReason is like transitionState but records why the state has been changed
+func (s *multisigService) transitionStateWithReason(multisigTx *model.MultisigTx, to model.MultisigTxState, reason string) error {
+	err := validateStateTransition(multisigTx.State, to)
 	if err != nil {
 		return err
 	}
+	updated, err := s.dao.UpdateStateWithReason(multisigTx.Id, multisigTx.State, to, reason)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrInvalidStateTransition
+	}
 
+	multisigTx.State = to
+	multisigTx.StateReason = reason
 	return nil
 }
 
+// updateThresholdState moves a pending tx to threshold_reached once enough owners have signed, and back
+// to pending if signatures have been withdrawn. A failure is only logged as the signatures have already
+// been stored.
+func (s *multisigService) updateThresholdState(multisigTx *model.MultisigTx) {
+	reached := thresholdReached(multisigTx, storedSigners(multisigTx))
+	var to model.MultisigTxState
+	switch {
+	case multisigTx.State == model.MultisigTxStatePending && reached:
+		to = model.MultisigTxStateThresholdReached
+	case multisigTx.State == model.MultisigTxStateThresholdReached && !reached:
+		to = model.MultisigTxStatePending
+	default:
+		return
+	}
+	err := s.transitionState(multisigTx, to)
+	if err != nil {
+		log.Printf("Updating state of multisig tx %s failed: %v", multisigTx.Id, err)
+		return
+	}
+	if to == model.MultisigTxStateThresholdReached {
+		s.publishEvent(model.MultisigTxEventThresholdReached, multisigTx, "")
+	}
+}
+
+// updateRejectionState moves a pending tx to rejected once the owners who have not rejected it cannot reach
+// the threshold anymore, and invalidates its children. A failure is only logged as the rejection vote has
+// already been stored.
+func (s *multisigService) updateRejectionState(multisigTx *model.MultisigTx) {
+	if multisigTx.State != model.MultisigTxStatePending || thresholdReachable(multisigTx) {
+		return
+	}
+	var rejecters []string
+	for _, owner := range multisigTx.Owners {
+		if owner.Rejection != "" {
+			rejecters = append(rejecters, owner.Address)
+		}
+	}
+	err := s.transitionStateWithReason(multisigTx, model.MultisigTxStateRejected, "rejected by owners: "+strings.Join(rejecters, ", "))
+	if err != nil {
+		log.Printf("Updating state of multisig tx %s failed: %v", multisigTx.Id, err)
+		return
+	}
+	invalidateChildTxs(s.dao, multisigTx)
+}
+
+func hasRejected(multisigTx *model.MultisigTx, address string) bool {
+	for _, owner := range multisigTx.Owners {
+		if owner.Address == address {
+			return owner.Rejection != ""
+		}
+	}
+	return false
+}
+
 func (s *multisigService) isOwner(multisigTx *model.MultisigTx, address string) (bool, bool) {
 	for _, owner := range multisigTx.Owners {
 		if owner.Address == address {
@@ -349,6 +1169,221 @@ func (s *multisigService) isCreatorOwner(owners []string, address string) bool {
 	return false
 }
 
+// verifyAliasOwner returns ErrAddressNotOwner unless the address is a current owner of the alias, including the
+// owners of nested aliases
+func (s *multisigService) verifyAliasOwner(alias string, address string) error {
+	aliasInfo, err := s.getAliasInfo(alias)
+	if err != nil {
+		return err
+	}
+	ownerTree, err := resolveOwnerTree(s.nodeService, aliasInfo.Result.Addresses, map[string]bool{alias: true}, 1)
+	if err != nil {
+		return err
+	}
+	owners := aliasInfo.Result.Addresses
+	if ownerTree != nil {
+		owners = signerKeys(ownerTree)
+	}
+	if !s.isCreatorOwner(owners, address) {
+		return ErrAddressNotOwner
+	}
+	return nil
+}
+
+// verifyCredentials recovers every signature of the signed tx credentials and makes sure that the
+// signatures of alias owners match the stored ones and that the threshold of the alias has been reached.
+// Signatures of non-owners (e.g. a node key) are left to the node to verify.
+func (s *multisigService) verifyCredentials(multisigTx *model.MultisigTx, utxHash []byte, creds []verify.Verifiable) error {
+	signers := make(map[string]bool)
+	for _, sig := range collectCredentialSigs(creds) {
+		signer, err := s.recoverAddress(utxHash, sig[:])
+		if err != nil {
+			return ErrParsingSignature
+		}
+		for _, owner := range multisigTx.Owners {
+			if owner.Address != signer {
+				continue
+			}
+			if !bytes.Equal(common.FromHex(owner.Signature), sig[:]) {
+				return ErrCredentialMismatch
+			}
+			signers[signer] = true
+			break
+		}
+	}
+	if !thresholdReached(multisigTx, signers) {
+		return ErrThresholdNotReached
+	}
+	return nil
+}
+
+// assembleSignedTx builds a ready-to-issue tx from the stored unsigned tx and owner signatures.
+// The signature indices of the inputs refer to the alias owners sorted by their short id, respectively
+// to the signer keys of the owner tree in depth-first order for aliases with nested aliases.
+func (s *multisigService) assembleSignedTx(multisigTx *model.MultisigTx) (*txs.Tx, error) {
+	if !thresholdReached(multisigTx, storedSigners(multisigTx)) {
+		return nil, ErrThresholdNotReached
+	}
+
+	var unsignedTx txs.UnsignedTx
+	err := s.unmarshalTx(multisigTx.UnsignedTx, &unsignedTx)
+	if err != nil {
+		return nil, ErrParsingTx
+	}
+
+	ownerSignatures, err := s.getSortedOwnerSignatures(multisigTx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigIndices := collectSigIndices(unsignedTx)
+	creds := make([]verify.Verifiable, 0, len(sigIndices))
+	for _, indices := range sigIndices {
+		cred := &secp256k1fx.Credential{
+			Sigs: make([][secp256k1.SignatureLen]byte, len(indices)),
+		}
+		for i, sigIndex := range indices {
+			if int(sigIndex) >= len(ownerSignatures) {
+				return nil, ErrSigIndexOutOfRange
+			}
+			ownerSignature := ownerSignatures[sigIndex]
+			if len(ownerSignature) != secp256k1.SignatureLen {
+				return nil, ErrMissingSignature
+			}
+			copy(cred.Sigs[i][:], ownerSignature)
+		}
+		creds = append(creds, cred)
+	}
+
+	signedTx := &txs.Tx{
+		Unsigned: unsignedTx,
+		Creds:    creds,
+	}
+	err = signedTx.Initialize(txs.Codec)
+	if err != nil {
+		return nil, ErrParsingTx
+	}
+	return signedTx, nil
+}
+
+// getSortedOwnerSignatures returns the signature bytes of all owners ordered by owner short id, or in the
+// depth-first order of the owner tree. Owners that have not signed yet have an empty signature.
+func (s *multisigService) getSortedOwnerSignatures(multisigTx *model.MultisigTx) ([][]byte, error) {
+	if multisigTx.OwnerTree != nil {
+		ownerSignatures := make(map[string][]byte, len(multisigTx.Owners))
+		for _, owner := range multisigTx.Owners {
+			ownerSignatures[owner.Address] = common.FromHex(owner.Signature)
+		}
+		var signatures [][]byte
+		walkSignerKeys(multisigTx.OwnerTree, func(addr string) {
+			signatures = append(signatures, ownerSignatures[addr])
+		})
+		return signatures, nil
+	}
+
+	type ownerSignature struct {
+		address   ids.ShortID
+		signature []byte
+	}
+	owners := make([]ownerSignature, 0, len(multisigTx.Owners))
+	for _, owner := range multisigTx.Owners {
+		addr, err := address.ParseToID(owner.Address)
+		if err != nil {
+			return nil, ErrParsingAddress
+		}
+		owners = append(owners, ownerSignature{
+			address:   addr,
+			signature: common.FromHex(owner.Signature),
+		})
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return bytes.Compare(owners[i].address[:], owners[j].address[:]) < 0
+	})
+
+	signatures := make([][]byte, len(owners))
+	for i, owner := range owners {
+		signatures[i] = owner.signature
+	}
+	return signatures, nil
+}
+
+// collectSigIndices walks the unsigned tx in serialization order and collects the signature indices
+// of every secp256k1fx input, i.e. of the tx inputs followed by any additional auth fields.
+func collectSigIndices(unsignedTx txs.UnsignedTx) [][]uint32 {
+	var sigIndices [][]uint32
+	walkTx(reflect.ValueOf(unsignedTx), func(v reflect.Value) bool {
+		if v.Type() != secpInputType {
+			return false
+		}
+		sigIndices = append(sigIndices, v.Interface().(secp256k1fx.Input).SigIndices)
+		return true
+	})
+	return sigIndices
+}
+
+// collectCredentialSigs collects the signatures of every secp256k1 credential of the signed tx.
+// Credentials are matched by their Sigs field so that camino credentials embedding it are covered too.
+func collectCredentialSigs(creds []verify.Verifiable) [][secp256k1.SignatureLen]byte {
+	var sigs [][secp256k1.SignatureLen]byte
+	walkTx(reflect.ValueOf(creds), func(v reflect.Value) bool {
+		sigsField := v.FieldByName("Sigs")
+		if !sigsField.IsValid() || sigsField.Type() != secpSigsType || !sigsField.CanInterface() {
+			return false
+		}
+		sigs = append(sigs, sigsField.Interface().([][secp256k1.SignatureLen]byte)...)
+		return true
+	})
+	return sigs
+}
+
+// walkTx visits every exported struct value reachable from v in serialization order.
+// The traversal does not descend into a struct for which visit returns true.
+func walkTx(v reflect.Value, visit func(v reflect.Value) bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkTx(v.Elem(), visit)
+		}
+	case reflect.Struct:
+		if visit(v) {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				walkTx(v.Field(i), visit)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walkTx(v.Index(i), visit)
+		}
+	}
+}
+
+// verifyAliasUnchanged re-resolves the alias of the tx and invalidates the tx if its owners or threshold, or those
+// of its nested aliases, have changed on chain since the tx has been created
+func (s *multisigService) verifyAliasUnchanged(multisigTx *model.MultisigTx) error {
+	aliasInfo, err := s.getAliasInfo(multisigTx.Alias)
+	if err != nil {
+		return err
+	}
+	ownerTree, err := resolveOwnerTree(s.nodeService, aliasInfo.Result.Addresses, map[string]bool{multisigTx.Alias: true}, 1)
+	if err != nil {
+		return err
+	}
+	invalidated, err := invalidateOnAliasDrift(s.dao, multisigTx, aliasInfo, ownerTree)
+	if err != nil {
+		return err
+	}
+	if invalidated {
+		return ErrAliasChanged
+	}
+	return nil
+}
+
 func (s *multisigService) getAliasInfo(alias string) (*model.AliasInfo, error) {
 	aliasInfo, err := s.nodeService.GetMultisigAlias(alias)
 	if err != nil {
@@ -360,7 +1395,6 @@ func (s *multisigService) getAliasInfo(alias string) (*model.AliasInfo, error) {
 
 func (s *multisigService) getAddressFromSignature(signatureArgs string, signature string, isHex bool) (string, error) {
 	var signatureArgsBytes []byte
-	var err error
 	if isHex {
 		signatureArgsBytes = common.FromHex(signatureArgs)
 	} else {
@@ -370,7 +1404,11 @@ func (s *multisigService) getAddressFromSignature(signatureArgs string, signatur
 	signatureArgsHash := hashing.ComputeHash256(signatureArgsBytes)
 	signatureBytes := common.FromHex(signature)
 
-	pub, err := s.secpFactory.RecoverHashPublicKey(signatureArgsHash, signatureBytes)
+	return s.recoverAddress(signatureArgsHash, signatureBytes)
+}
+
+func (s *multisigService) recoverAddress(hash []byte, signature []byte) (string, error) {
+	pub, err := s.secpFactory.RecoverHashPublicKey(hash, signature)
 	if err != nil {
 		return "", err
 	}
@@ -400,6 +1438,26 @@ func (s *multisigService) generateId(unsignedTx string) (string, error) {
 	return fmt.Sprintf("%x", hashing.ComputeHash256(txBytes)), nil
 }
 
+// getInputIds returns the ids of all utxos consumed by the unsigned tx
+func (s *multisigService) getInputIds(txHexString string) ([]string, error) {
+	var unsignedTx txs.UnsignedTx
+	err := s.unmarshalTx(txHexString, &unsignedTx)
+	if err != nil {
+		return nil, ErrParsingTx
+	}
+
+	inputIds := make([]string, 0)
+	walkTx(reflect.ValueOf(unsignedTx), func(v reflect.Value) bool {
+		if v.Type() != transferableInType {
+			return false
+		}
+		in := v.Interface().(avax.TransferableInput)
+		inputIds = append(inputIds, in.InputID().String())
+		return true
+	})
+	return inputIds, nil
+}
+
 func (s *multisigService) getChainId(txHexString string) (string, error) {
 	var unsignedTx txs.UnsignedTx
 	err := s.unmarshalTx(txHexString, &unsignedTx)
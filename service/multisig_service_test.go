@@ -6,23 +6,46 @@
 package service
 
 import (
+	"errors"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/chain4travel/camino-signavault/dao"
 	"github.com/chain4travel/camino-signavault/dto"
 	"github.com/chain4travel/camino-signavault/model"
 	"github.com/chain4travel/camino-signavault/util"
+	"github.com/ethereum/go-ethereum/common"
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/golang-migrate/migrate/v4/database/mysql"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 )
 
 const networkId = uint32(1002)
 
+// aliasInfoOf returns the alias info of the given tx as it is returned by the node
+func aliasInfoOf(multisigTx model.MultisigTx, threshold int) *model.AliasInfo {
+	addresses := make([]string, 0, len(multisigTx.Owners))
+	for _, owner := range multisigTx.Owners {
+		addresses = append(addresses, owner.Address)
+	}
+	return &model.AliasInfo{
+		Jsonrpc: "2.0",
+		Result: model.Result{
+			Memo:      "0x",
+			Addresses: addresses,
+			Threshold: strconv.Itoa(threshold),
+		},
+		Id: 1,
+	}
+}
+
 func TestCreateMultisigTx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockNodeService := NewMockNodeService(ctrl)
@@ -56,7 +79,9 @@ func TestCreateMultisigTx(t *testing.T) {
 		TransactionId: "",
 		OutputOwners:  "OutputOwners",
 		Metadata:      "",
+		State:         model.MultisigTxStatePending,
 		Expiration:    &nowPlus2Secs,
+		InputIds:      []string{},
 		Owners: []model.MultisigTxOwner{
 			{
 				MultisigTxId: id,
@@ -71,16 +96,20 @@ func TestCreateMultisigTx(t *testing.T) {
 		},
 	}
 
-	mockDao.EXPECT().CreateMultisigTx(&mockTx).Return(mockTx.Id, nil)
+	mockDao.EXPECT().CreateMultisigTx(&mockTx, gomock.Any()).Return(mockTx.Id, nil)
 	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", false).Return(nil, ErrTxNotExists).Times(1)
 	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", false).Return(&[]model.MultisigTx{mockTx}, nil).Times(1)
 	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
-	mockDao.EXPECT().PendingAliasExists("P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99e", "11111111111111111111111111111111LpoYY").Return(true, nil)
-	mockDao.EXPECT().PendingAliasExists(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mockDao.EXPECT().GetConflictingTxIds([]string{}).Return([]string{}, nil).Times(1)
 	mockNodeService.EXPECT().GetMultisigAlias(alias).Return(mockAliasInfo, nil).AnyTimes()
+	// the owners of the alias are signer keys
+	for _, owner := range mockAliasInfo.Result.Addresses {
+		mockNodeService.EXPECT().GetMultisigAlias(owner).Return(nil, errAliasInfoNotFound).AnyTimes()
+	}
 	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound)
 
-	mockDeleteTxOwnersAndUpdateID := mockDao.EXPECT().DeleteTxOwnersAndUpdateID(gomock.Any(), gomock.Any()).Return(nil)
+	mockUpdateState := mockDao.EXPECT().UpdateState(mockTx.Id, model.MultisigTxStatePending, model.MultisigTxStateExpired).Return(true, nil)
+	mockArchiveTx := mockDao.EXPECT().ArchiveTx(mockTx.Id, gomock.Any()).Return(nil)
 
 	type args struct {
 		multisigTx *dto.MultisigTxArgs
@@ -117,16 +146,19 @@ func TestCreateMultisigTx(t *testing.T) {
 			err: errAliasInfoNotFound,
 		},
 		{
-			name: "Duplicate alias",
+			name: "Inputs already spent by a pending tx",
 			args: args{
 				multisigTx: &dto.MultisigTxArgs{
-					Alias:        "P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99e",
+					Alias:        alias,
 					UnsignedTx:   mockTx.UnsignedTx,
 					Signature:    mockTx.Owners[0].Signature,
 					OutputOwners: mockTx.OutputOwners,
 				},
 			},
-			err: ErrPendingTx,
+			err: &ConflictingInputsError{TxIds: []string{"conflictingTxId"}},
+			prepare: func() {
+				mockDao.EXPECT().GetConflictingTxIds([]string{}).Return([]string{"conflictingTxId"}, nil).Times(1)
+			},
 		},
 		{
 			name: "Create new multisig tx and archive the identical expired one",
@@ -141,22 +173,24 @@ func TestCreateMultisigTx(t *testing.T) {
 			},
 			err: nil,
 			prepare: func() {
-				mockDeleteTxOwnersAndUpdateID.Times(1)
+				mockUpdateState.Times(1)
+				mockArchiveTx.Times(1)
+				mockDao.EXPECT().GetConflictingTxIds([]string{}).Return([]string{}, nil).Times(1)
 				newExpiration := mockTx.Expiration.Add(time.Second * 5)
 				newMockTx := mockTx
 				newMockTx.Expiration = &newExpiration
-				mockDao.EXPECT().CreateMultisigTx(&newMockTx).Return(mockTx.Id, nil)
+				mockDao.EXPECT().CreateMultisigTx(&newMockTx, gomock.Any()).Return(mockTx.Id, nil)
 				time.Sleep(time.Second * 5) // wait for 1st successful tx to expire
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			_, err := s.CreateMultisigTx(tt.args.multisigTx)
+			_, err := s.CreateMultisigTx(tt.args.multisigTx, nil)
 
 			if tt.err != nil {
 				require.Equal(t, tt.err, err)
@@ -237,12 +271,16 @@ func TestGetAllMultisigTxForAlias(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
 			got, err := s.GetAllMultisigTxForAlias(tt.args.alias, tt.args.timestamp, tt.args.signature)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetAllMultisigTxForAlias() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			// the decoded block depends on the codec and is covered by TestDecodeUnsignedTx
+			for i := range *got {
+				(*got)[i].Decoded = nil
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetAllMultisigTxForAlias() got = %v, want %v", got, tt.want)
 			}
@@ -311,7 +349,7 @@ func TestGetMultisigTx(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
 			got, err := s.GetMultisigTx(tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetMultisigTx() error = %v, wantErr %v", err, tt.wantErr)
@@ -324,6 +362,395 @@ func TestGetMultisigTx(t *testing.T) {
 	}
 }
 
+func TestGetMultisigTxHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	owner := "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	timestamp := "1678877386"
+	signature := "47bf8e8601badef42a1157e07862157ded68fff927bc3809d5abb0d4a7c51cad3e53979193dc7069f73fe3f7b1b9e8a5946a1bd4782a565fe126a627634943dd01"
+
+	expiration := time.Now().UTC().Add(-time.Hour)
+	issuedTx := model.MultisigTx{
+		Id:            "1",
+		Alias:         alias,
+		TransactionId: "transactionId",
+		State:         model.MultisigTxStateCommitted,
+		Issuer:        owner,
+	}
+	expiredTx := model.MultisigTx{
+		Id:         "2",
+		Alias:      alias,
+		State:      model.MultisigTxStatePending,
+		Expiration: &expiration,
+	}
+	expectedExpiredTx := expiredTx
+	expectedExpiredTx.State = model.MultisigTxStateExpired
+
+	mockDao.EXPECT().GetMultisigTxHistory(alias, owner, defaultHistoryLimit, 0).Return(&[]model.MultisigTx{issuedTx, expiredTx}, 2, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTxHistory(alias, owner, maxHistoryLimit, 10).Return(nil, 2, nil).Times(1)
+
+	type args struct {
+		signature string
+		limit     int
+		offset    int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *dto.MultisigTxHistoryResponse
+		wantErr bool
+	}{
+		{
+			name: "Get history with default limit",
+			args: args{
+				signature: signature,
+			},
+			want: &dto.MultisigTxHistoryResponse{
+				Transactions: []model.MultisigTx{issuedTx, expectedExpiredTx},
+				Total:        2,
+				Limit:        defaultHistoryLimit,
+				Offset:       0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Get history with limit above maximum",
+			args: args{
+				signature: signature,
+				limit:     1000,
+				offset:    10,
+			},
+			want: &dto.MultisigTxHistoryResponse{
+				Transactions: []model.MultisigTx{},
+				Total:        2,
+				Limit:        maxHistoryLimit,
+				Offset:       10,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Get history with invalid signature",
+			args: args{
+				signature: "invalid",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetMultisigTxHistory(alias, timestamp, tt.args.signature, tt.args.limit, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMultisigTxHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetPendingMultisigTxForOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl for address + timestamp
+	owner := "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"
+	timestamp := "1678877386"
+	signature := "55e45d4bfd96a979f65d04c4ff5800a93a89733e6001affaaf5a589140d4c1614cc476be61ce91b584a504b804b050307dea2a0c61ed947226f0432a291a406001"
+
+	now := time.Now().UTC()
+	expiresSoon := now.Add(time.Hour)
+	expiresLater := now.Add(24 * time.Hour)
+	laterTx := model.MultisigTx{
+		Id:         "1",
+		Alias:      "alias_1",
+		Threshold:  2,
+		State:      model.MultisigTxStatePending,
+		Expiration: &expiresLater,
+		Owners: []model.MultisigTxOwner{
+			{MultisigTxId: "1", Address: owner},
+			{MultisigTxId: "1", Address: "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68", Signature: "signature"},
+		},
+	}
+	withoutExpirationTx := model.MultisigTx{
+		Id:        "2",
+		Alias:     "alias_2",
+		Threshold: 1,
+		State:     model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{MultisigTxId: "2", Address: owner},
+		},
+	}
+	soonTx := model.MultisigTx{
+		Id:         "3",
+		Alias:      "alias_3",
+		Threshold:  1,
+		State:      model.MultisigTxStatePending,
+		Expiration: &expiresSoon,
+		Owners: []model.MultisigTxOwner{
+			{MultisigTxId: "3", Address: owner},
+		},
+	}
+
+	mockDao.EXPECT().GetPendingTxForOwner(owner).Return(&[]model.MultisigTx{laterTx, withoutExpirationTx, soonTx}, nil).Times(1)
+
+	tests := []struct {
+		name      string
+		address   string
+		signature string
+		want      *[]dto.PendingTx
+		wantErr   error
+	}{
+		{
+			name:      "Get pending txs of owner",
+			address:   owner,
+			signature: signature,
+			want: &[]dto.PendingTx{
+				{MultisigTx: soonTx, Signatures: 0},
+				{MultisigTx: laterTx, Signatures: 1},
+				{MultisigTx: withoutExpirationTx, Signatures: 0},
+			},
+		},
+		{
+			name:      "Get pending txs of another address",
+			address:   "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+			signature: signature,
+			wantErr:   ErrAddressNotSigner,
+		},
+		{
+			name:      "Get pending txs with invalid signature",
+			address:   owner,
+			signature: "invalid",
+			wantErr:   ErrParsingSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetPendingMultisigTxForOwner(tt.address, timestamp, tt.signature)
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetMultisigTxForOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+
+	mockTx := model.MultisigTx{
+		Id:            id,
+		Alias:         "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:     2,
+		TransactionId: "transactionId",
+		State:         model.MultisigTxStateIssued,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+				Signature:    "signature",
+			},
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			},
+		},
+	}
+
+	mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{mockTx}, nil).Times(2)
+	mockDao.EXPECT().GetMultisigTx("unknown", "", "", false).Return(nil, nil).Times(1)
+
+	type args struct {
+		id        string
+		timestamp string
+		signature string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *model.MultisigTx
+		err  error
+	}{
+		{
+			name: "Get issued multisig tx",
+			args: args{
+				id:        id,
+				timestamp: timestamp,
+				signature: requestSignature,
+			},
+			want: &mockTx,
+		},
+		{
+			name: "Get multisig tx - not an owner",
+			args: args{
+				id:        id,
+				timestamp: "1678877387",
+				signature: requestSignature,
+			},
+			err: ErrAddressNotOwner,
+		},
+		{
+			name: "Get multisig tx - non existing id",
+			args: args{
+				id:        "unknown",
+				timestamp: timestamp,
+				signature: requestSignature,
+			},
+			err: ErrTxNotExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetMultisigTxForOwner(tt.args.id, tt.args.timestamp, tt.args.signature)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetChildMultisigTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+
+	parentTx := model.MultisigTx{
+		Id:            id,
+		Alias:         "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:     1,
+		TransactionId: "transactionId",
+		State:         model.MultisigTxStateIssued,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+				Signature:    "signature",
+			},
+		},
+	}
+	childTx := model.MultisigTx{
+		Id:                "child",
+		Alias:             parentTx.Alias,
+		Threshold:         1,
+		ParentTransaction: "transactionId",
+		State:             model.MultisigTxStatePending,
+	}
+
+	mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{parentTx}, nil).Times(2)
+	mockDao.EXPECT().GetChildTxIds([]string{id, "transactionId"}).Return([]string{"child"}, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTx("child", "", "", false).Return(&[]model.MultisigTx{childTx}, nil).Times(1)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		want      *[]model.MultisigTx
+		err       error
+	}{
+		{
+			name:      "Get children of multisig tx",
+			timestamp: timestamp,
+			want:      &[]model.MultisigTx{childTx},
+		},
+		{
+			name:      "Get children of multisig tx - not an owner",
+			timestamp: "1678877387",
+			err:       ErrAddressNotOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetChildMultisigTxs(id, tt.timestamp, requestSignature)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetDecodedMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+
+	mockTx := model.MultisigTx{
+		Id:         id,
+		UnsignedTx: "invalid",
+		Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:  2,
+		State:      model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+			},
+		},
+	}
+
+	mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{mockTx}, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTx("unknown", "", "", false).Return(nil, nil).Times(1)
+
+	tests := []struct {
+		name string
+		id   string
+		err  error
+	}{
+		{
+			name: "Decode multisig tx - invalid unsigned tx",
+			id:   id,
+			err:  ErrParsingTx,
+		},
+		{
+			name: "Decode multisig tx - non existing id",
+			id:   "unknown",
+			err:  ErrTxNotExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetDecodedMultisigTx(tt.id, timestamp, requestSignature)
+			require.Equal(t, tt.err, err)
+			require.Nil(t, got)
+		})
+	}
+}
+
 func TestSignMultisigTx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockNodeService := NewMockNodeService(ctrl)
@@ -373,22 +800,35 @@ func TestSignMultisigTx(t *testing.T) {
 		},
 	}
 
+	mockTxWithChangedAlias := mockTx
+	mockTxWithChangedAlias.Id = "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d30"
+	mockTxWithChangedAlias.Alias = "P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99e"
+	mockTxWithChangedAlias.State = model.MultisigTxStatePending
+
+	mockNodeService.EXPECT().GetMultisigAlias(mockTx.Alias).Return(aliasInfoOf(mockTx, 2), nil).AnyTimes()
+	// an owner has been removed from the alias after the tx has been created
+	mockNodeService.EXPECT().GetMultisigAlias(mockTxWithChangedAlias.Alias).Return(aliasInfoOf(model.MultisigTx{Owners: mockTx.Owners[:1]}, 1), nil).AnyTimes()
+	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(mockTxWithChangedAlias.Id, "", "", true).Return(&[]model.MultisigTx{mockTxWithChangedAlias}, nil).AnyTimes()
+	mockDao.EXPECT().UpdateStateWithReason(mockTxWithChangedAlias.Id, model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+		"threshold changed from 2 to 1; owners removed: P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3").Return(true, nil).Times(1)
 	// mock without signer
 	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
-	mockDao.EXPECT().AddSigner(mockTx.Id, "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000", mockTx.Owners[0].Address).Return(true, nil).AnyTimes()
+	mockDao.EXPECT().AddSigner(mockTx.Id, "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000", mockTx.Owners[0].Address, gomock.Any()).Return(true, nil).AnyTimes()
 	// mock with existing signer
 	mockDao.EXPECT().GetMultisigTx(mockTxWithSigner.Id, "", "", true).Return(&[]model.MultisigTx{mockTxWithSigner}, nil).AnyTimes()
-	mockDao.EXPECT().AddSigner(mockTxWithSigner.Id, "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000", mockTx.Owners[0].Address).Return(false, nil).AnyTimes()
+	mockDao.EXPECT().AddSigner(mockTxWithSigner.Id, "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000", mockTx.Owners[0].Address, gomock.Any()).Return(false, nil).AnyTimes()
 
 	type args struct {
 		id       string
 		signArgs *dto.SignTxArgs
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    *model.MultisigTx
-		wantErr bool
+		name       string
+		args       args
+		want       *model.MultisigTx
+		wantEvents int
+		wantErr    bool
 	}{
 		{
 			name: "Sign multisig tx",
@@ -398,8 +838,9 @@ func TestSignMultisigTx(t *testing.T) {
 					Signature: "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
 				},
 			},
-			want:    &mockTx,
-			wantErr: false,
+			want:       &mockTx,
+			wantEvents: 1,
+			wantErr:    false,
 		},
 		{
 			name: "Sign multisig tx with existing signature",
@@ -412,11 +853,23 @@ func TestSignMultisigTx(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Sign multisig tx - alias has changed",
+			args: args{
+				id: mockTxWithChangedAlias.Id,
+				signArgs: &dto.SignTxArgs{
+					Signature: "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
-			got, err := s.SignMultisigTx(tt.args.id, tt.args.signArgs)
+			publisher := &recordingEventPublisher{}
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, publisher, nil)
+			got, err := s.SignMultisigTx(tt.args.id, tt.args.signArgs, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SignMultisigTx() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -424,11 +877,12 @@ func TestSignMultisigTx(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("SignMultisigTx() got = %v, want %v", got, tt.want)
 			}
+			require.Len(t, publisher.events, tt.wantEvents)
 		})
 	}
 }
 
-func TestIssueMultisigTx(t *testing.T) {
+func TestSignMultisigTxWithAutoIssue(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockNodeService := NewMockNodeService(ctrl)
 	mockDao := dao.NewMockMultisigTxDao(ctrl)
@@ -436,47 +890,599 @@ func TestIssueMultisigTx(t *testing.T) {
 		NetworkId: networkId,
 	}
 
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+	signature0 := "dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b100"
+	signature1 := "6e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01"
 	mockTx := model.MultisigTx{
-		Id:            "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
-		UnsignedTx:    "000000002004000003ea010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		Id:            id,
+		UnsignedTx:    "000000002007000003ea00000000000000000000000000000000000000000000000000000000000000000000000159eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000700016bcc41d9bdc0000000000000000000000001000000015d008196f8da54c34bd67dc5ef5bae4948389cb8000000010903208c79e9d29ad5e5ea7caf771ecca4db7a218c44d7c3619deea62e6227640000000359eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000500016bcc41e9000000000002000000000000000100000000000000000000000000000000000000000000000083b1ddd7b166dbe6305c22fed5f59065525c4e510000000a00000001000000005d008196f8da54c34bd67dc5ef5bae4948389cb8",
 		Alias:         "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
 		Threshold:     2,
 		ChainId:       "11111111111111111111111111111111LpoYY",
 		TransactionId: "",
+		AutoIssue:     true,
+		State:         model.MultisigTxStatePending,
 		Owners: []model.MultisigTxOwner{
 			{
-				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				MultisigTxId: id,
 				Address:      "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+				Signature:    signature0,
 			},
 			{
-				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				MultisigTxId: id,
 				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
-				Signature:    "",
 			},
 		},
 	}
-
-	// mock without signer
-	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
-	mockDao.EXPECT().UpdateTransactionId(mockTx.Id, gomock.Any()).Return(true, nil).AnyTimes()
+	mockTxWithThreshold := mockTx
+	mockTxWithThreshold.Owners = []model.MultisigTxOwner{
+		mockTx.Owners[0],
+		{
+			MultisigTxId: id,
+			Address:      mockTx.Owners[1].Address,
+			Signature:    signature1,
+		},
+	}
 	txId, _ := ids.FromString("3N3j8FpRtvx9UAJrsS6CTcsUQPCmRqf4Hjnfp81CuEJSMcqJ2")
-	mockNodeService.EXPECT().IssueTx(gomock.Any()).Return(txId, nil).AnyTimes()
 
-	type args struct {
-		issueArgs *dto.IssueTxArgs
+	mockNodeService.EXPECT().GetMultisigAlias(mockTx.Alias).Return(aliasInfoOf(mockTx, 2), nil).Times(1)
+	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+	// the first call returns the tx before, the second one after signing
+	mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{mockTxWithThreshold}, nil).Times(1)
+	mockDao.EXPECT().AddSigner(id, signature1, mockTx.Owners[1].Address, gomock.Any()).Return(true, nil).Times(1)
+	mockDao.EXPECT().UpdateState(id, model.MultisigTxStatePending, model.MultisigTxStateThresholdReached).Return(true, nil).Times(1)
+	mockNodeService.EXPECT().IssueTx(gomock.Any()).Return(txId, nil).Times(1)
+	mockDao.EXPECT().UpdateTransactionId(id, txId.String(), mockTx.Owners[1].Address, gomock.Any()).
+		Do(func(_, _, _ string, event *model.AuditEvent) {
+			// the auto issue is audited as an issue of the signer whose signature reached the threshold
+			require.Equal(t, model.AuditActionIssue, event.Action)
+			require.Equal(t, mockTx.Owners[1].Address, event.Actor)
+			require.Equal(t, signature1, event.Signature)
+			require.Equal(t, "request_id", event.RequestId)
+		}).Return(true, nil).Times(1)
+
+	s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+	got, err := s.SignMultisigTx(id, &dto.SignTxArgs{Signature: signature1}, &model.RequestInfo{RequestId: "request_id"})
+	require.NoError(t, err)
+	require.Equal(t, txId.String(), got.TransactionId)
+	require.Equal(t, model.MultisigTxStateIssued, got.State)
+}
+
+func TestSignMultisigTxBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
 	}
-	tests := []struct {
-		name    string
-		args    args
+
+	signature := "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000"
+	mockTx := model.MultisigTx{
+		Id:         "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d28",
+		UnsignedTx: "000000002004000003ea010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:  2,
+		ChainId:    "11111111111111111111111111111111LpoYY",
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d28",
+				Address:      "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+			},
+			{
+				MultisigTxId: "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d28",
+				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			},
+		},
+	}
+	notExistingId := "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d31"
+	signers := []model.MultisigTxOwner{
+		{
+			MultisigTxId: mockTx.Id,
+			Address:      mockTx.Owners[0].Address,
+			Signature:    signature,
+		},
+	}
+
+	mockNodeService.EXPECT().GetMultisigAlias(mockTx.Alias).Return(aliasInfoOf(mockTx, 2), nil).AnyTimes()
+	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(notExistingId, "", "", true).Return(&[]model.MultisigTx{}, nil).AnyTimes()
+
+	t.Run("Sign batch of multisig txs", func(t *testing.T) {
+		mockDao.EXPECT().AddSigners(signers, gomock.Any()).Return(true, nil).Times(1)
+
+		s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+		got, err := s.SignMultisigTxBatch(&dto.SignBatchArgs{
+			Signatures: []dto.SignBatchItem{
+				{Id: mockTx.Id, Signature: signature},
+				{Id: notExistingId, Signature: signature},
+				{Id: mockTx.Id, Signature: "00"},
+				{Id: mockTx.Id, Signature: signature},
+			},
+		}, nil)
+		require.NoError(t, err)
+		require.Equal(t, &dto.SignBatchResponse{
+			Results: []dto.SignBatchResult{
+				{Id: mockTx.Id, Tx: &mockTx},
+				{Id: notExistingId, Error: ErrTxNotExists.Error()},
+				{Id: mockTx.Id, Error: ErrParsingSignature.Error()},
+				{Id: mockTx.Id, Error: ErrOwnerHasSigned.Error()},
+			},
+		}, got)
+	})
+
+	t.Run("Sign batch of multisig txs - storing the signatures fails", func(t *testing.T) {
+		mockDao.EXPECT().AddSigners(signers, gomock.Any()).Return(false, errors.New("db error")).Times(1)
+
+		s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+		_, err := s.SignMultisigTxBatch(&dto.SignBatchArgs{
+			Signatures: []dto.SignBatchItem{
+				{Id: mockTx.Id, Signature: signature},
+			},
+		}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Sign batch of multisig txs - a tx has been changed concurrently", func(t *testing.T) {
+		mockDao.EXPECT().AddSigners(signers, gomock.Any()).Return(false, nil).Times(1)
+
+		publisher := &recordingEventPublisher{}
+		s := NewMultisigService(mockConfig, mockDao, mockNodeService, publisher, nil)
+		got, err := s.SignMultisigTxBatch(&dto.SignBatchArgs{
+			Signatures: []dto.SignBatchItem{
+				{Id: mockTx.Id, Signature: signature},
+			},
+		}, nil)
+		require.NoError(t, err)
+		require.Equal(t, &dto.SignBatchResponse{
+			Results: []dto.SignBatchResult{
+				{Id: mockTx.Id, Error: ErrBatchNotStored.Error()},
+			},
+		}, got)
+		require.Empty(t, publisher.events)
+	})
+
+	t.Run("Sign batch without valid signatures", func(t *testing.T) {
+		s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+		got, err := s.SignMultisigTxBatch(&dto.SignBatchArgs{
+			Signatures: []dto.SignBatchItem{
+				{Id: notExistingId, Signature: signature},
+				{Id: mockTx.Id, Signature: ""},
+			},
+		}, nil)
+		require.NoError(t, err)
+		require.Equal(t, &dto.SignBatchResponse{
+			Results: []dto.SignBatchResult{
+				{Id: notExistingId, Error: ErrTxNotExists.Error()},
+				{Id: mockTx.Id, Error: ErrEmptySignature.Error()},
+			},
+		}, got)
+	})
+}
+
+func TestWithdrawSignature(t *testing.T) {
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+
+	signedTx := model.MultisigTx{
+		Id:        id,
+		Alias:     "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold: 1,
+		State:     model.MultisigTxStateThresholdReached,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+				Signature:    "signature",
+			},
+			{
+				MultisigTxId: id,
+				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+			},
+		},
+	}
+	withdrawnAt := time.Now().UTC()
+	withdrawnTx := signedTx
+	withdrawnTx.Owners = []model.MultisigTxOwner{
+		{
+			MultisigTxId: id,
+			Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+			WithdrawnAt:  &withdrawnAt,
+		},
+		signedTx.Owners[1],
+	}
+
+	tests := []struct {
+		name      string
+		timestamp string
+		mockFn    func(mockDao *dao.MockMultisigTxDao)
+		wantState model.MultisigTxState
+		err       error
+	}{
+		{
+			name:      "Withdraw signature below threshold",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{signedTx}, nil).Times(1)
+				mockDao.EXPECT().WithdrawSigner(id, signedTx.Owners[0].Address, gomock.Any()).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{withdrawnTx}, nil).Times(1)
+				mockDao.EXPECT().UpdateState(id, model.MultisigTxStateThresholdReached, model.MultisigTxStatePending).Return(true, nil).Times(1)
+			},
+			wantState: model.MultisigTxStatePending,
+		},
+		{
+			name:      "Owner has not signed",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{withdrawnTx}, nil).Times(1)
+				mockDao.EXPECT().WithdrawSigner(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrOwnerHasNotSigned,
+		},
+		{
+			name:      "Tx issued in the meantime",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{signedTx}, nil).Times(1)
+				mockDao.EXPECT().WithdrawSigner(id, signedTx.Owners[0].Address, gomock.Any()).Return(false, nil).Times(1)
+			},
+			err: ErrInvalidStateTransition,
+		},
+		{
+			name:      "Not an owner",
+			timestamp: "1678877387",
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{signedTx}, nil).Times(1)
+				mockDao.EXPECT().WithdrawSigner(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrAddressNotOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			tt.mockFn(mockDao)
+
+			s := NewMultisigService(mockConfig, mockDao, NewMockNodeService(ctrl), &recordingEventPublisher{}, nil)
+			got, err := s.WithdrawSignature(id, tt.timestamp, requestSignature, nil)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, tt.wantState, got.State)
+				require.Empty(t, got.Owners[0].Signature)
+				require.NotNil(t, got.Owners[0].WithdrawnAt)
+			}
+		})
+	}
+}
+
+func TestRejectMultisigTx(t *testing.T) {
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68 for "reject" + id + timestamp
+	rejection := "c9c5911a53da18ead0df71716ddebeb1655f9c3b083e1ed144f0db78994e07452a706f6a2776bdd5804a568f0278121d35552dc36905bd2101ce4aa95ba13b0a00"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+	rejecter := "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+
+	pendingTx := func(threshold int8, rejecterOwner model.MultisigTxOwner) model.MultisigTx {
+		return model.MultisigTx{
+			Id:        id,
+			Alias:     "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+			Threshold: threshold,
+			State:     model.MultisigTxStatePending,
+			Owners: []model.MultisigTxOwner{
+				rejecterOwner,
+				{
+					MultisigTxId: id,
+					Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+					Rejection:    "rejection",
+				},
+				{
+					MultisigTxId: id,
+					Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+				},
+			},
+		}
+	}
+	undecided := model.MultisigTxOwner{MultisigTxId: id, Address: rejecter}
+	rejected := model.MultisigTxOwner{MultisigTxId: id, Address: rejecter, Rejection: rejection}
+	signed := model.MultisigTxOwner{MultisigTxId: id, Address: rejecter, Signature: "signature"}
+
+	tests := []struct {
+		name      string
+		timestamp string
+		mockFn    func(mockDao *dao.MockMultisigTxDao)
+		wantState model.MultisigTxState
+		err       error
+	}{
+		{
+			name:      "Reject until threshold is unreachable",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(2, undecided)}, nil).Times(1)
+				mockDao.EXPECT().AddRejection(id, rejection, rejecter, gomock.Any()).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(2, rejected)}, nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason(id, model.MultisigTxStatePending, model.MultisigTxStateRejected,
+					"rejected by owners: P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68, P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3").Return(true, nil).Times(1)
+				mockDao.EXPECT().GetChildTxIds([]string{id}).Return([]string{}, nil).Times(1)
+			},
+			wantState: model.MultisigTxStateRejected,
+		},
+		{
+			name:      "Reject with threshold still reachable",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(1, undecided)}, nil).Times(1)
+				mockDao.EXPECT().AddRejection(id, rejection, rejecter, gomock.Any()).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(1, rejected)}, nil).Times(1)
+				mockDao.EXPECT().UpdateStateWithReason(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantState: model.MultisigTxStatePending,
+		},
+		{
+			name:      "Owner has signed",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(2, signed)}, nil).Times(1)
+				mockDao.EXPECT().AddRejection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrOwnerHasSigned,
+		},
+		{
+			name:      "Owner has already rejected",
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(1, rejected)}, nil).Times(1)
+				mockDao.EXPECT().AddRejection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrOwnerHasRejected,
+		},
+		{
+			name:      "Not an owner",
+			timestamp: "1678877387",
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{pendingTx(2, undecided)}, nil).Times(1)
+				mockDao.EXPECT().AddRejection(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrAddressNotOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			tt.mockFn(mockDao)
+
+			publisher := &recordingEventPublisher{}
+			s := NewMultisigService(mockConfig, mockDao, NewMockNodeService(ctrl), publisher, nil)
+			got, err := s.RejectMultisigTx(id, &dto.RejectTxArgs{Timestamp: tt.timestamp, Signature: rejection}, nil)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, tt.wantState, got.State)
+				require.Len(t, publisher.events, 1)
+				require.Equal(t, model.MultisigTxEventRejected, publisher.events[0].Type)
+				require.Equal(t, rejecter, publisher.events[0].Actor)
+			} else {
+				require.Empty(t, publisher.events)
+			}
+		})
+	}
+}
+
+func TestExtendMultisigTx(t *testing.T) {
+	// signature of owner P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl for "extend" + id + expiration + timestamp
+	signature := "e6c6ac5b5b3edab0eea209ff120a60e2c04fbe158b55c053897f004e515d2bcc77e2ed9a07b000e87704dd7943567c70e8f62384da71cc0c17435057428895f000"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+	owner := "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"
+	var expiration int64 = 4102444800
+	expiresAt := time.Unix(expiration, 0).UTC()
+
+	tomorrow := time.Now().UTC().Add(time.Hour * 24)
+	yesterday := time.Now().UTC().Add(-time.Hour * 24)
+	later := expiresAt.Add(time.Hour)
+	storedTx := func(state model.MultisigTxState, expiration *time.Time) model.MultisigTx {
+		return model.MultisigTx{
+			Id:         id,
+			Alias:      "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+			Threshold:  2,
+			State:      state,
+			Expiration: expiration,
+			Owners: []model.MultisigTxOwner{
+				{
+					MultisigTxId: id,
+					Address:      owner,
+				},
+				{
+					MultisigTxId: id,
+					Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+				},
+			},
+		}
+	}
+	extendedTx := storedTx(model.MultisigTxStatePending, &expiresAt)
+	extendedTx.ExtendedBy = owner
+
+	tests := []struct {
+		name      string
+		config    *util.Config
+		timestamp string
+		mockFn    func(mockDao *dao.MockMultisigTxDao)
+		err       error
+	}{
+		{
+			name:      "Extend expiration",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStatePending, &tomorrow)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(id, expiresAt, owner, gomock.Any()).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetMultisigTx(id, "", "", true).Return(&[]model.MultisigTx{extendedTx}, nil).Times(1)
+			},
+		},
+		{
+			name:      "Expiration exceeds the maximum",
+			config:    &util.Config{NetworkId: networkId},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStatePending, &tomorrow)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrExpirationTooLate,
+		},
+		{
+			name:      "Expiration is not after the current one",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStatePending, &later)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrExpirationNotExtended,
+		},
+		{
+			name:      "Tx has already expired",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStatePending, &yesterday)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrExpired,
+		},
+		{
+			name:      "Tx has been issued",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStateIssued, &tomorrow)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrTxNotPending,
+		},
+		{
+			name:      "Tx has been issued concurrently",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: timestamp,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStateThresholdReached, &tomorrow)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(id, expiresAt, owner, gomock.Any()).Return(false, nil).Times(1)
+			},
+			err: ErrTxNotPending,
+		},
+		{
+			name:      "Not an owner",
+			config:    &util.Config{NetworkId: networkId, TxMaxExpiration: 36500},
+			timestamp: "1678877387",
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{storedTx(model.MultisigTxStatePending, &tomorrow)}, nil).Times(1)
+				mockDao.EXPECT().UpdateExpirationDate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			err: ErrAddressNotOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			tt.mockFn(mockDao)
+
+			s := NewMultisigService(tt.config, mockDao, NewMockNodeService(ctrl), &recordingEventPublisher{}, nil)
+			got, err := s.ExtendMultisigTx(id, &dto.ExtendTxArgs{Expiration: expiration, Timestamp: tt.timestamp, Signature: signature}, nil)
+			require.Equal(t, tt.err, err)
+			if tt.err == nil {
+				require.Equal(t, expiresAt, *got.Expiration)
+				require.Equal(t, owner, got.ExtendedBy)
+			}
+		})
+	}
+}
+
+func TestIssueMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	mockTx := model.MultisigTx{
+		Id:            "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+		UnsignedTx:    "000000002004000003ea010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		Alias:         "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:     2,
+		ChainId:       "11111111111111111111111111111111LpoYY",
+		TransactionId: "",
+		State:         model.MultisigTxStateThresholdReached,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				Address:      "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+				Signature:    "dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b100",
+			},
+			{
+				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+				Signature:    "6e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01",
+			},
+		},
+	}
+	mockTxWithOtherSignature := mockTx
+	mockTxWithOtherSignature.Owners = []model.MultisigTxOwner{
+		mockTx.Owners[0],
+		{
+			MultisigTxId: mockTx.Id,
+			Address:      mockTx.Owners[1].Address,
+			Signature:    "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
+		},
+	}
+	mockTxWithHigherThreshold := mockTx
+	mockTxWithHigherThreshold.Threshold = 3
+
+	// every test case fetches the stored tx once
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).Times(2)
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTxWithOtherSignature}, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTxWithHigherThreshold}, nil).Times(1)
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).Times(1)
+	// the alias is re-fetched by every test case with a valid signature
+	mockNodeService.EXPECT().GetMultisigAlias(mockTx.Alias).Return(aliasInfoOf(mockTx, 2), nil).Times(2)
+	mockNodeService.EXPECT().GetMultisigAlias(mockTx.Alias).Return(aliasInfoOf(mockTx, 3), nil).Times(2)
+	mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+	mockDao.EXPECT().UpdateStateWithReason(mockTx.Id, model.MultisigTxStateThresholdReached, model.MultisigTxStateInvalidated,
+		"threshold changed from 2 to 3").Return(true, nil).Times(1)
+	mockDao.EXPECT().UpdateTransactionId(mockTx.Id, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	txId, _ := ids.FromString("3N3j8FpRtvx9UAJrsS6CTcsUQPCmRqf4Hjnfp81CuEJSMcqJ2")
+	mockNodeService.EXPECT().IssueTx(gomock.Any()).Return(txId, nil).AnyTimes()
+
+	signedTx := "000000002007000003ea00000000000000000000000000000000000000000000000000000000000000000000000159eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000700016bcc41d9bdc0000000000000000000000001000000015d008196f8da54c34bd67dc5ef5bae4948389cb8000000010903208c79e9d29ad5e5ea7caf771ecca4db7a218c44d7c3619deea62e6227640000000359eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000500016bcc41e9000000000002000000000000000100000000000000000000000000000000000000000000000083b1ddd7b166dbe6305c22fed5f59065525c4e510000000a00000001000000005d008196f8da54c34bd67dc5ef5bae4948389cb8000000030000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e010000000200000000000000010000200c00000001a32fc319922bf20632f85f5c99c3ecdf88387cf28564452403e81d635c805c736d2217e83cc33dea85311039a2745fc4bcd28f4b822799b819dc858a6391dd810100000001000000000000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01000000020000000000000001"
+	issuerSignature := "9b0d10e2b321b54edac30aae019bc0ceb639d3c1f312cd65d8dbafe735e14ccc39b11974f4efd29c11a9dccc140878ba689294a1c91d5d569a44b9665a0031fb01"
+
+	type args struct {
+		issueArgs *dto.IssueTxArgs
+	}
+	tests := []struct {
+		name    string
+		args    args
 		want    ids.ID
 		wantErr bool
+		err     error
 	}{
 		{
 			name: "Issue multisig tx",
 			args: args{
 				issueArgs: &dto.IssueTxArgs{
-					SignedTx:  "000000002007000003ea00000000000000000000000000000000000000000000000000000000000000000000000159eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000700016bcc41d9bdc0000000000000000000000001000000015d008196f8da54c34bd67dc5ef5bae4948389cb8000000010903208c79e9d29ad5e5ea7caf771ecca4db7a218c44d7c3619deea62e6227640000000359eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000500016bcc41e9000000000002000000000000000100000000000000000000000000000000000000000000000083b1ddd7b166dbe6305c22fed5f59065525c4e510000000a00000001000000005d008196f8da54c34bd67dc5ef5bae4948389cb8000000030000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e010000000200000000000000010000200c00000001a32fc319922bf20632f85f5c99c3ecdf88387cf28564452403e81d635c805c736d2217e83cc33dea85311039a2745fc4bcd28f4b822799b819dc858a6391dd810100000001000000000000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01000000020000000000000001",
-					Signature: "9b0d10e2b321b54edac30aae019bc0ceb639d3c1f312cd65d8dbafe735e14ccc39b11974f4efd29c11a9dccc140878ba689294a1c91d5d569a44b9665a0031fb01",
+					SignedTx:  signedTx,
+					Signature: issuerSignature,
 				},
 			},
 			want:    txId,
@@ -486,22 +1492,61 @@ func TestIssueMultisigTx(t *testing.T) {
 			name: "Issue multisig tx - invalid signature",
 			args: args{
 				issueArgs: &dto.IssueTxArgs{
-					SignedTx:  "000000002007000003ea00000000000000000000000000000000000000000000000000000000000000000000000159eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000700016bcc41d9bdc0000000000000000000000001000000015d008196f8da54c34bd67dc5ef5bae4948389cb8000000010903208c79e9d29ad5e5ea7caf771ecca4db7a218c44d7c3619deea62e6227640000000359eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000500016bcc41e9000000000002000000000000000100000000000000000000000000000000000000000000000083b1ddd7b166dbe6305c22fed5f59065525c4e510000000a00000001000000005d008196f8da54c34bd67dc5ef5bae4948389cb8000000030000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e010000000200000000000000010000200c00000001a32fc319922bf20632f85f5c99c3ecdf88387cf28564452403e81d635c805c736d2217e83cc33dea85311039a2745fc4bcd28f4b822799b819dc858a6391dd810100000001000000000000200c00000002dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b1006e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01000000020000000000000001",
+					SignedTx:  signedTx,
 					Signature: "9b0d10e2b321b54edac30aae019bc0ceb639d3c1f312cd65d8dbafe735e14ccc39b11974f4efd29c11a9dccc140878ba689294a1c91d5d569a44b9665a0031fb02",
 				},
 			},
 			want:    ids.Empty,
 			wantErr: true,
 		},
+		{
+			name: "Issue multisig tx - credential does not match stored signature",
+			args: args{
+				issueArgs: &dto.IssueTxArgs{
+					SignedTx:  signedTx,
+					Signature: issuerSignature,
+				},
+			},
+			want:    ids.Empty,
+			wantErr: true,
+			err:     ErrCredentialMismatch,
+		},
+		{
+			name: "Issue multisig tx - threshold not reached",
+			args: args{
+				issueArgs: &dto.IssueTxArgs{
+					SignedTx:  signedTx,
+					Signature: issuerSignature,
+				},
+			},
+			want:    ids.Empty,
+			wantErr: true,
+			err:     ErrThresholdNotReached,
+		},
+		{
+			name: "Issue multisig tx - alias has changed",
+			args: args{
+				issueArgs: &dto.IssueTxArgs{
+					SignedTx:  signedTx,
+					Signature: issuerSignature,
+				},
+			},
+			want:    ids.Empty,
+			wantErr: true,
+			err:     ErrAliasChanged,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
-			got, err := s.IssueMultisigTx(tt.args.issueArgs)
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.IssueMultisigTx(tt.args.issueArgs, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("IssueMultisigTx() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("IssueMultisigTx() got = %v, want %v", got, tt.want)
 			}
@@ -513,8 +1558,16 @@ func TestCancelMultisigTx(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockNodeService := NewMockNodeService(ctrl)
 	mockDao := dao.NewMockMultisigTxDao(ctrl)
-	mockConfig := &util.Config{
-		NetworkId: networkId,
+
+	// the creator signs the cancel requests with a fresh timestamp
+	creatorKey, err := (&secp256k1.Factory{}).ToPrivateKey(common.FromHex("0b9f2e1c3d4a5b6c7d8e9f00112233445566778899aabbccddeeff0011223344"))
+	require.NoError(t, err)
+	creator := "P-kopernikus1cc7pxwm7rycaayznl29s00zc0xnmc8tnav2ccl"
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signCancel := func(id string, timestamp string) string {
+		signature, err := creatorKey.Sign([]byte(cancelSignaturePrefix + id + timestamp))
+		require.NoError(t, err)
+		return common.Bytes2Hex(signature)
 	}
 
 	mockTx := model.MultisigTx{
@@ -524,10 +1577,12 @@ func TestCancelMultisigTx(t *testing.T) {
 		Threshold:     2,
 		ChainId:       "11111111111111111111111111111111LpoYY",
 		TransactionId: "",
+		State:         model.MultisigTxStatePending,
+		Creator:       creator,
 		Owners: []model.MultisigTxOwner{
 			{
 				MultisigTxId: "cec9762115a58339c0f5e9ae582c1879300c1ff7303f9b566a95cf5ebe2a9d28",
-				Address:      "P-kopernikus1yzq6k26nsyuzssj8j9k6x6x7fqgndtadk66948",
+				Address:      creator,
 				Signature:    "4d974561be4675853e0bc6062eac412228e94b16c6ba86dcfedccc1ef2b2a5156ab5aaddbd11f9d88786563fe9f3c17ca5e44a9936621b027b3179284dd86dc000",
 			},
 			{
@@ -538,38 +1593,288 @@ func TestCancelMultisigTx(t *testing.T) {
 		},
 	}
 
+	otherCreatorTx := mockTx
+	otherCreatorTx.Id = "otherCreator"
+	otherCreatorTx.Creator = "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"
+
+	sameCreatorTx := mockTx
+	sameCreatorTx.Id = "sameCreator"
+
+	// the tx as stored before and after the cancel vote of the owner
+	quorumTx := mockTx
+	quorumTx.Id = "quorum"
+	votedAt := time.Now().UTC()
+	votedQuorumTx := quorumTx
+	votedQuorumTx.Owners = []model.MultisigTxOwner{mockTx.Owners[0], mockTx.Owners[1]}
+	votedQuorumTx.Owners[0].CancelVotedAt = &votedAt
+
 	// mock without signer
 	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
-	mockDao.EXPECT().DeletePendingTx(mockTx.Id).Return(true, nil).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(otherCreatorTx.Id, "", "", true).Return(&[]model.MultisigTx{otherCreatorTx}, nil).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(sameCreatorTx.Id, "", "", true).Return(&[]model.MultisigTx{sameCreatorTx}, nil).AnyTimes()
+	mockDao.EXPECT().CancelTx(gomock.Any(), model.MultisigTxStatePending, mockTx.Creator, gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	mockDao.EXPECT().GetChildTxIds(gomock.Any()).Return([]string{}, nil).AnyTimes()
 
 	type args struct {
 		cancelArgs *dto.CancelTxArgs
 	}
 	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
+		name      string
+		config    *util.Config
+		args      args
+		mockFn    func()
+		wantState model.MultisigTxState
+		wantErr   error
 	}{
 		{
-			name: "Cancel multisig tx",
+			name:   "Cancel multisig tx",
+			config: &util.Config{NetworkId: networkId},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        mockTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(mockTx.Id, timestamp),
+					Reason:    "wrong amount",
+				},
+			},
+			wantState: model.MultisigTxStateCancelled,
+		},
+		{
+			name:   "Cancel multisig tx with the signature for another tx - should fail",
+			config: &util.Config{NetworkId: networkId},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        sameCreatorTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(mockTx.Id, timestamp),
+				},
+			},
+			wantErr: ErrAddressNotOwner,
+		},
+		{
+			name:   "Cancel multisig tx with a stale timestamp - should fail",
+			config: &util.Config{NetworkId: networkId},
 			args: args{
 				cancelArgs: &dto.CancelTxArgs{
 					Id:        mockTx.Id,
 					Timestamp: "1678877386",
-					Signature: "47bf8e8601badef42a1157e07862157ded68fff927bc3809d5abb0d4a7c51cad3e53979193dc7069f73fe3f7b1b9e8a5946a1bd4782a565fe126a627634943dd01",
+					Signature: signCancel(mockTx.Id, "1678877386"),
 				},
 			},
-			wantErr: false,
+			wantErr: ErrStaleTimestamp,
+		},
+		{
+			name:   "Cancel multisig tx of another creator - should fail",
+			config: &util.Config{NetworkId: networkId},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        otherCreatorTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(otherCreatorTx.Id, timestamp),
+				},
+			},
+			wantErr: ErrNotCreator,
+		},
+		{
+			name:   "Cancel multisig tx of another creator with owner policy",
+			config: &util.Config{NetworkId: networkId, CancelPolicy: util.CancelPolicyOwner},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        otherCreatorTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(otherCreatorTx.Id, timestamp),
+				},
+			},
+			wantState: model.MultisigTxStateCancelled,
+		},
+		{
+			name:   "Vote to cancel multisig tx below quorum",
+			config: &util.Config{NetworkId: networkId, CancelPolicy: util.CancelPolicyQuorum},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        quorumTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(quorumTx.Id, timestamp),
+				},
+			},
+			mockFn: func() {
+				mockDao.EXPECT().GetMultisigTx(quorumTx.Id, "", "", true).Return(&[]model.MultisigTx{quorumTx}, nil).Times(1)
+				mockDao.EXPECT().AddCancelVote(quorumTx.Id, mockTx.Creator, gomock.Any()).DoAndReturn(func(id string, owner string, event *model.AuditEvent) (bool, error) {
+					require.Equal(t, payloadHash([]byte(cancelSignaturePrefix+quorumTx.Id+timestamp)), event.PayloadHash)
+					return true, nil
+				}).Times(1)
+				mockDao.EXPECT().GetMultisigTx(quorumTx.Id, "", "", true).Return(&[]model.MultisigTx{votedQuorumTx}, nil).Times(1)
+			},
+			wantState: model.MultisigTxStatePending,
+		},
+		{
+			name:   "Vote to cancel multisig tx reaching quorum",
+			config: &util.Config{NetworkId: networkId, CancelPolicy: util.CancelPolicyQuorum, CancelQuorum: 1},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        quorumTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(quorumTx.Id, timestamp),
+				},
+			},
+			mockFn: func() {
+				mockDao.EXPECT().GetMultisigTx(quorumTx.Id, "", "", true).Return(&[]model.MultisigTx{quorumTx}, nil).Times(1)
+				mockDao.EXPECT().AddCancelVote(quorumTx.Id, mockTx.Creator, gomock.Any()).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetMultisigTx(quorumTx.Id, "", "", true).Return(&[]model.MultisigTx{votedQuorumTx}, nil).Times(1)
+			},
+			wantState: model.MultisigTxStateCancelled,
+		},
+		{
+			name:   "Vote to cancel multisig tx twice - should fail",
+			config: &util.Config{NetworkId: networkId, CancelPolicy: util.CancelPolicyQuorum},
+			args: args{
+				cancelArgs: &dto.CancelTxArgs{
+					Id:        quorumTx.Id,
+					Timestamp: timestamp,
+					Signature: signCancel(quorumTx.Id, timestamp),
+				},
+			},
+			mockFn: func() {
+				mockDao.EXPECT().GetMultisigTx(quorumTx.Id, "", "", true).Return(&[]model.MultisigTx{votedQuorumTx}, nil).Times(1)
+				mockDao.EXPECT().AddCancelVote(quorumTx.Id, mockTx.Creator, gomock.Any()).Return(false, nil).Times(1)
+			},
+			wantErr: ErrOwnerHasVotedToCancel,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewMultisigService(mockConfig, mockDao, mockNodeService)
-			err := s.CancelMultisigTx(tt.args.cancelArgs)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("CancelMultisigTx() error = %v, wantErr %v", err, tt.wantErr)
+			if tt.mockFn != nil {
+				tt.mockFn()
+			}
+			publisher := &recordingEventPublisher{}
+			s := NewMultisigService(tt.config, mockDao, mockNodeService, publisher, nil)
+			got, err := s.CancelMultisigTx(tt.args.cancelArgs, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantState, got.State)
+			if tt.wantState == model.MultisigTxStateCancelled {
+				require.Equal(t, mockTx.Creator, got.CancelledBy)
+				require.Equal(t, tt.args.cancelArgs.Reason, got.StateReason)
+				require.Len(t, publisher.events, 1)
+				require.Equal(t, model.MultisigTxEventCancelled, publisher.events[0].Type)
+				require.Equal(t, mockTx.Creator, publisher.events[0].Actor)
+			} else {
+				require.Empty(t, publisher.events)
+			}
+		})
+	}
+}
+
+func TestGetSignedMultisigTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	signature0 := "dd3be02c98a8d121e6a0e3bb123117db44bfc0ec78cc73e5a0b87a92afccd6d71d4f952bba5ff34defd3626cd3b3c86816c384f4f2c5241a75393da4b77572b100"
+	signature1 := "6e19b48ad5ab9ed3e7d774bef7aae5d9047b773075c7372a3736022f7064e66a32a567eb5112d32061622a1cfd33ff4076579a0ab962fad9547816c095277d6e01"
+
+	mockTx := model.MultisigTx{
+		Id:            "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+		UnsignedTx:    "000000002007000003ea00000000000000000000000000000000000000000000000000000000000000000000000159eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000700016bcc41d9bdc0000000000000000000000001000000015d008196f8da54c34bd67dc5ef5bae4948389cb8000000010903208c79e9d29ad5e5ea7caf771ecca4db7a218c44d7c3619deea62e6227640000000359eb48b8b3a928ca9d6b90a0f3492ab47ebf06e9edc553cfb6bcd2d3f38e319a0000000500016bcc41e9000000000002000000000000000100000000000000000000000000000000000000000000000083b1ddd7b166dbe6305c22fed5f59065525c4e510000000a00000001000000005d008196f8da54c34bd67dc5ef5bae4948389cb8",
+		Alias:         "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		Threshold:     2,
+		ChainId:       "11111111111111111111111111111111LpoYY",
+		TransactionId: "",
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				Address:      "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh",
+			},
+			{
+				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				Address:      "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+				Signature:    signature1,
+			},
+			{
+				MultisigTxId: "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac",
+				Address:      "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68",
+				Signature:    signature0,
+			},
+		},
+	}
+	mockTxBelowThreshold := mockTx
+	mockTxBelowThreshold.Id = "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ad"
+	mockTxBelowThreshold.Threshold = 3
+
+	mockDao.EXPECT().GetMultisigTx(mockTx.Id, "", "", true).Return(&[]model.MultisigTx{mockTx}, nil).AnyTimes()
+	mockDao.EXPECT().GetMultisigTx(mockTxBelowThreshold.Id, "", "", true).Return(&[]model.MultisigTx{mockTxBelowThreshold}, nil).AnyTimes()
+
+	type args struct {
+		id        string
+		timestamp string
+		signature string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCreds [][]string
+		err       error
+	}{
+		{
+			name: "Assemble signed tx",
+			args: args{
+				id:        mockTx.Id,
+				timestamp: timestamp,
+				signature: requestSignature,
+			},
+			// the input is signed by owners 0 and 1, the node owner auth by owner 0 (owners sorted by short id)
+			wantCreds: [][]string{{signature0, signature1}, {signature0}},
+		},
+		{
+			name: "Assemble signed tx - invalid request signature",
+			args: args{
+				id:        mockTx.Id,
+				timestamp: "1678877387",
+				signature: requestSignature,
+			},
+			err: ErrAddressNotOwner,
+		},
+		{
+			name: "Assemble signed tx - threshold not reached",
+			args: args{
+				id:        mockTxBelowThreshold.Id,
+				timestamp: timestamp,
+				signature: "b6b9d33472a38433a4bf5a5aacc3cc479d4d9ed8a287bd9bfc05a88494b7e2f65d6b34f4f84ddfcd37eb25a5a775d0cbe9297044a85ea1d96a86ba1f61f7a28200",
+			},
+			err: ErrThresholdNotReached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetSignedMultisigTx(tt.args.id, tt.args.timestamp, tt.args.signature)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
 				return
 			}
+			require.NoError(t, err)
+
+			var signedTx txs.Tx
+			_, err = txs.Codec.Unmarshal(common.FromHex(got), &signedTx)
+			require.NoError(t, err)
+			require.Len(t, signedTx.Creds, len(tt.wantCreds))
+			for i, wantSigs := range tt.wantCreds {
+				cred, ok := signedTx.Creds[i].(*secp256k1fx.Credential)
+				require.True(t, ok)
+				require.Len(t, cred.Sigs, len(wantSigs))
+				for j, wantSig := range wantSigs {
+					require.Equal(t, wantSig, common.Bytes2Hex(cred.Sigs[j][:]))
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,65 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+var ErrInvalidStateTransition = errors.New("invalid multisig transaction state transition")
+
+// stateTransitions defines the allowed transitions of the multisig tx lifecycle. A tx goes back from
+// threshold_reached to pending if a signature is withdrawn. A tx is rejected either by the node after it has
+// been issued or by its owners before. Committed, rejected, expired, stale, invalidated and cancelled txs
+// are final.
+var stateTransitions = map[model.MultisigTxState][]model.MultisigTxState{
+	model.MultisigTxStatePending: {
+		model.MultisigTxStateThresholdReached,
+		model.MultisigTxStateRejected,
+		model.MultisigTxStateExpired,
+		model.MultisigTxStateStale,
+		model.MultisigTxStateInvalidated,
+		model.MultisigTxStateCancelled,
+	},
+	model.MultisigTxStateThresholdReached: {
+		model.MultisigTxStatePending,
+		model.MultisigTxStateIssued,
+		model.MultisigTxStateExpired,
+		model.MultisigTxStateStale,
+		model.MultisigTxStateInvalidated,
+		model.MultisigTxStateCancelled,
+	},
+	model.MultisigTxStateIssued: {
+		model.MultisigTxStateCommitted,
+		model.MultisigTxStateRejected,
+	},
+}
+
+func validateStateTransition(from model.MultisigTxState, to model.MultisigTxState) error {
+	for _, state := range stateTransitions[from] {
+		if state == to {
+			return nil
+		}
+	}
+	return ErrInvalidStateTransition
+}
+
+// isActiveState returns true if the tx is still collecting signatures or can be issued
+func isActiveState(state model.MultisigTxState) bool {
+	return state == model.MultisigTxStatePending || state == model.MultisigTxStateThresholdReached
+}
+
+// isFailedState returns true if the tx has ended without being committed
+func isFailedState(state model.MultisigTxState) bool {
+	switch state {
+	case model.MultisigTxStateRejected, model.MultisigTxStateExpired, model.MultisigTxStateStale,
+		model.MultisigTxStateInvalidated, model.MultisigTxStateCancelled:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStateTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from model.MultisigTxState
+		to   model.MultisigTxState
+		err  error
+	}{
+		{
+			name: "Pending to threshold reached",
+			from: model.MultisigTxStatePending,
+			to:   model.MultisigTxStateThresholdReached,
+		},
+		{
+			name: "Pending to cancelled",
+			from: model.MultisigTxStatePending,
+			to:   model.MultisigTxStateCancelled,
+		},
+		{
+			name: "Threshold reached to issued",
+			from: model.MultisigTxStateThresholdReached,
+			to:   model.MultisigTxStateIssued,
+		},
+		{
+			name: "Issued to committed",
+			from: model.MultisigTxStateIssued,
+			to:   model.MultisigTxStateCommitted,
+		},
+		{
+			name: "Threshold reached to pending",
+			from: model.MultisigTxStateThresholdReached,
+			to:   model.MultisigTxStatePending,
+		},
+		{
+			name: "Threshold reached to stale",
+			from: model.MultisigTxStateThresholdReached,
+			to:   model.MultisigTxStateStale,
+		},
+		{
+			name: "Stale is final",
+			from: model.MultisigTxStateStale,
+			to:   model.MultisigTxStateThresholdReached,
+			err:  ErrInvalidStateTransition,
+		},
+		{
+			name: "Pending to issued",
+			from: model.MultisigTxStatePending,
+			to:   model.MultisigTxStateIssued,
+			err:  ErrInvalidStateTransition,
+		},
+		{
+			name: "Issued to cancelled",
+			from: model.MultisigTxStateIssued,
+			to:   model.MultisigTxStateCancelled,
+			err:  ErrInvalidStateTransition,
+		},
+		{
+			name: "Cancelled is final",
+			from: model.MultisigTxStateCancelled,
+			to:   model.MultisigTxStatePending,
+			err:  ErrInvalidStateTransition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.err, validateStateTransition(tt.from, tt.to))
+		})
+	}
+}
@@ -16,18 +16,25 @@ import (
 	"strings"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 
 	"github.com/chain4travel/camino-signavault/model"
 	"github.com/chain4travel/camino-signavault/util"
 )
 
+const maxUTXOsPageSize = 1024
+
 var errAliasInfoNotFound = errors.New("could not find address info from node - address does not exist")
 
 type NodeService interface {
 	GetMultisigAlias(alias string) (*model.AliasInfo, error)
 	IssueTx(txBytes []byte) (ids.ID, error)
 	GetAllDepositOffers(args *platformvm.GetAllDepositOffersArgs) (*platformvm.GetAllDepositOffersReply, error)
+	GetTxStatus(txID ids.ID) (*platformvm.GetTxStatusResponse, error)
+	GetUTXOIds(address string) ([]ids.ID, error)
 }
 
 type nodeService struct {
@@ -86,6 +93,40 @@ func (s *nodeService) GetAllDepositOffers(args *platformvm.GetAllDepositOffersAr
 	return s.client.GetAllDepositOffers(context.Background(), args)
 }
 
+func (s *nodeService) GetTxStatus(txID ids.ID) (*platformvm.GetTxStatusResponse, error) {
+	return s.client.GetTxStatus(context.Background(), txID)
+}
+
+// GetUTXOIds returns the ids of all utxos, including the locked ones, owned by the given P-chain address
+func (s *nodeService) GetUTXOIds(addr string) ([]ids.ID, error) {
+	shortAddr, err := address.ParseToID(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	utxoIds := make([]ids.ID, 0)
+	startAddress := ids.ShortEmpty
+	startUTXOID := ids.Empty
+	for {
+		utxosBytes, endAddress, endUTXOID, err := s.client.GetUTXOs(context.Background(), []ids.ShortID{shortAddr}, maxUTXOsPageSize, startAddress, startUTXOID)
+		if err != nil {
+			return nil, err
+		}
+		for _, utxoBytes := range utxosBytes {
+			utxo := &avax.UTXO{}
+			_, err = txs.Codec.Unmarshal(utxoBytes, utxo)
+			if err != nil {
+				return nil, err
+			}
+			utxoIds = append(utxoIds, utxo.InputID())
+		}
+		if len(utxosBytes) < maxUTXOsPageSize {
+			return utxoIds, nil
+		}
+		startAddress, startUTXOID = endAddress, endUTXOID
+	}
+}
+
 func (s *nodeService) unmarshal(data []byte, v interface{}) error {
 	dec := json.NewDecoder(bytes.NewReader(data))
 	return dec.Decode(v)
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+)
+
+var ErrUnknownOutboxSink = errors.New("unknown outbox sink")
+
+const (
+	defaultOutboxRelayInterval = 1 * time.Second
+	defaultOutboxMaxAttempts   = 60
+	outboxRelayBatchSize       = 100
+)
+
+// names of the outbox sinks in the config
+const (
+	OutboxSinkWebhook = "webhook"
+	OutboxSinkLog     = "log"
+)
+
+// OutboxSink receives the lifecycle events relayed from the outbox. Events are delivered at least once, so a sink
+// has to tolerate receiving an event again, which it can recognize by the idempotency key of the event. Other
+// destinations, e.g. a message broker, are added by implementing this interface.
+type OutboxSink interface {
+	Name() string
+	Deliver(event model.MultisigTxEvent) error
+}
+
+// OutboxRelay periodically relays the events stored in the outbox to the sinks, in the order they have been
+// stored. An event is removed from the outbox once all sinks have accepted it. If a sink fails, the event and
+// all events after it are retried on the next run, until the event is moved to the dead letters once the
+// maximum number of attempts has been reached.
+type OutboxRelay interface {
+	Start(ctx context.Context)
+}
+
+type outboxRelay struct {
+	config *util.Config
+	dao    dao.MultisigTxDao
+	sinks  []OutboxSink
+}
+
+func NewOutboxRelay(config *util.Config, dao dao.MultisigTxDao, sinks []OutboxSink) OutboxRelay {
+	return &outboxRelay{
+		config: config,
+		dao:    dao,
+		sinks:  sinks,
+	}
+}
+
+func (r *outboxRelay) Start(ctx context.Context) {
+	interval := defaultOutboxRelayInterval
+	if r.config.OutboxRelayInterval > 0 {
+		interval = time.Duration(r.config.OutboxRelayInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.relayPendingEvents(); err != nil {
+					log.Printf("failed to relay outbox events: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (r *outboxRelay) relayPendingEvents() error {
+	events, err := r.dao.GetPendingOutboxEvents(outboxRelayBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range *events {
+		event := &(*events)[i]
+		sinkErr := r.deliver(event.Event)
+		if sinkErr == nil {
+			err = r.dao.DeleteOutboxEvent(event.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		event.Attempts++
+		if event.Attempts >= r.maxAttempts() {
+			// the event is given up so that it doesn't block the events after it
+			log.Printf("outbox event %d (%s of tx %s) failed %d times, moving it to the dead letters: %v",
+				event.Id, event.Event.Type, event.Event.TxId, event.Attempts, sinkErr)
+			err = r.dao.MoveOutboxEventToDeadLetters(event, sinkErr.Error())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err = r.dao.RecordOutboxFailure(event.Id, sinkErr.Error())
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("couldn't relay outbox event %d, attempt %d: %w", event.Id, event.Attempts, sinkErr)
+	}
+	return nil
+}
+
+// deliver passes the event to all sinks and stops at the first sink which fails
+func (r *outboxRelay) deliver(event model.MultisigTxEvent) error {
+	for _, sink := range r.sinks {
+		err := sink.Deliver(event)
+		if err != nil {
+			return fmt.Errorf("%s: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (r *outboxRelay) maxAttempts() int {
+	if r.config.OutboxMaxAttempts > 0 {
+		return r.config.OutboxMaxAttempts
+	}
+	return defaultOutboxMaxAttempts
+}
+
+// NewOutboxSinks returns the sinks named in the config, the webhook sink if none are configured
+func NewOutboxSinks(config *util.Config, webhookDao dao.WebhookDao) ([]OutboxSink, error) {
+	names := config.OutboxSinks
+	if len(names) == 0 {
+		names = []string{OutboxSinkWebhook}
+	}
+
+	sinks := make([]OutboxSink, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case OutboxSinkWebhook:
+			sinks = append(sinks, NewWebhookOutboxSink(webhookDao))
+		case OutboxSinkLog:
+			sinks = append(sinks, NewPublisherOutboxSink(OutboxSinkLog, NewLogEventPublisher()))
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownOutboxSink, name)
+		}
+	}
+	return sinks, nil
+}
+
+type publisherOutboxSink struct {
+	name      string
+	publisher EventPublisher
+}
+
+// NewPublisherOutboxSink returns a sink which passes the events on to a publisher that cannot fail
+func NewPublisherOutboxSink(name string, publisher EventPublisher) OutboxSink {
+	return &publisherOutboxSink{
+		name:      name,
+		publisher: publisher,
+	}
+}
+
+func (s *publisherOutboxSink) Name() string {
+	return s.name
+}
+
+func (s *publisherOutboxSink) Deliver(event model.MultisigTxEvent) error {
+	s.publisher.Publish(event)
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingOutboxSink records the delivered events and fails for the events of the given tx
+type recordingOutboxSink struct {
+	events  []model.MultisigTxEvent
+	failFor string
+}
+
+func (s *recordingOutboxSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingOutboxSink) Deliver(event model.MultisigTxEvent) error {
+	if event.TxId == s.failFor {
+		return errors.New("sink unavailable")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestRelayPendingEvents(t *testing.T) {
+	events := []model.OutboxEvent{
+		{Id: 1, Event: model.MultisigTxEvent{Type: model.MultisigTxEventCreated, TxId: "1", IdempotencyKey: "key1"}},
+		{Id: 2, Event: model.MultisigTxEvent{Type: model.MultisigTxEventSigned, TxId: "2", IdempotencyKey: "key2"}},
+		{Id: 3, Event: model.MultisigTxEvent{Type: model.MultisigTxEventSigned, TxId: "1", IdempotencyKey: "key3"}},
+	}
+
+	tests := []struct {
+		name       string
+		failFor    string
+		attempts   int
+		mockFn     func(mockDao *dao.MockMultisigTxDao)
+		wantEvents []model.MultisigTxEvent
+		wantErr    bool
+	}{
+		{
+			name: "All events are relayed and removed",
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				gomock.InOrder(
+					mockDao.EXPECT().DeleteOutboxEvent(int64(1)).Return(nil),
+					mockDao.EXPECT().DeleteOutboxEvent(int64(2)).Return(nil),
+					mockDao.EXPECT().DeleteOutboxEvent(int64(3)).Return(nil),
+				)
+			},
+			wantEvents: []model.MultisigTxEvent{events[0].Event, events[1].Event, events[2].Event},
+		},
+		{
+			name:    "A failed event stays pending and blocks the events after it",
+			failFor: "2",
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				mockDao.EXPECT().DeleteOutboxEvent(int64(1)).Return(nil).Times(1)
+				mockDao.EXPECT().RecordOutboxFailure(int64(2), "recording: sink unavailable").Return(nil).Times(1)
+			},
+			wantEvents: []model.MultisigTxEvent{events[0].Event},
+			wantErr:    true,
+		},
+		{
+			name:     "An event failing too often is moved to the dead letters",
+			failFor:  "2",
+			attempts: defaultOutboxMaxAttempts - 1,
+			mockFn: func(mockDao *dao.MockMultisigTxDao) {
+				gomock.InOrder(
+					mockDao.EXPECT().DeleteOutboxEvent(int64(1)).Return(nil),
+					mockDao.EXPECT().MoveOutboxEventToDeadLetters(gomock.Any(), "recording: sink unavailable").
+						Do(func(event *model.OutboxEvent, _ string) {
+							require.Equal(t, int64(2), event.Id)
+							require.Equal(t, defaultOutboxMaxAttempts, event.Attempts)
+						}).Return(nil),
+					mockDao.EXPECT().DeleteOutboxEvent(int64(3)).Return(nil),
+				)
+				mockDao.EXPECT().RecordOutboxFailure(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantEvents: []model.MultisigTxEvent{events[0].Event, events[2].Event},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			pending := make([]model.OutboxEvent, len(events))
+			copy(pending, events)
+			pending[1].Attempts = tt.attempts
+			mockDao.EXPECT().GetPendingOutboxEvents(outboxRelayBatchSize).Return(&pending, nil).Times(1)
+			tt.mockFn(mockDao)
+
+			sink := &recordingOutboxSink{failFor: tt.failFor}
+			relay := NewOutboxRelay(&util.Config{}, mockDao, []OutboxSink{sink}).(*outboxRelay)
+			err := relay.relayPendingEvents()
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantEvents, sink.events)
+		})
+	}
+}
+
+func TestNewOutboxSinks(t *testing.T) {
+	sinks, err := NewOutboxSinks(&util.Config{}, nil)
+	require.NoError(t, err)
+	require.Len(t, sinks, 1)
+	require.Equal(t, OutboxSinkWebhook, sinks[0].Name())
+
+	sinks, err = NewOutboxSinks(&util.Config{OutboxSinks: []string{OutboxSinkWebhook, OutboxSinkLog}}, nil)
+	require.NoError(t, err)
+	require.Len(t, sinks, 2)
+	require.Equal(t, OutboxSinkLog, sinks[1].Name())
+
+	_, err = NewOutboxSinks(&util.Config{OutboxSinks: []string{"kafka"}}, nil)
+	require.ErrorIs(t, err, ErrUnknownOutboxSink)
+}
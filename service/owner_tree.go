@@ -0,0 +1,218 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+// maxOwnerTreeDepth is the maximum number of alias levels below the alias of a tx
+const maxOwnerTreeDepth = 4
+
+var ErrInvalidOwnerTree = errors.New("the nested aliases of the alias are cyclic or nested too deep")
+
+// resolveOwnerTree resolves the given owners of an alias recursively through the node. Owners which are
+// multisig aliases themselves become nodes with their own owners and threshold, all other owners are
+// signer keys. The owners of every level are sorted by their short id, as the signature indices of the
+// tx inputs refer to the signer keys of the tree in depth-first order. It returns nil if none of the
+// owners is an alias.
+func resolveOwnerTree(nodeService NodeService, owners []string, path map[string]bool, depth int) ([]model.OwnerNode, error) {
+	nodes := make([]model.OwnerNode, 0, len(owners))
+	nested := false
+	for _, owner := range owners {
+		if path[owner] || depth > maxOwnerTreeDepth {
+			return nil, ErrInvalidOwnerTree
+		}
+		aliasInfo, err := nodeService.GetMultisigAlias(owner)
+		if err != nil && err != errAliasInfoNotFound {
+			return nil, err
+		}
+		if err != nil || len(aliasInfo.Result.Addresses) == 0 {
+			// not an alias
+			nodes = append(nodes, model.OwnerNode{Address: owner})
+			continue
+		}
+
+		threshold, err := strconv.Atoi(aliasInfo.Result.Threshold)
+		if err != nil {
+			return nil, ErrThresholdParsing
+		}
+		path[owner] = true
+		children, err := resolveOwnerTree(nodeService, aliasInfo.Result.Addresses, path, depth+1)
+		delete(path, owner)
+		if err != nil {
+			return nil, err
+		}
+		if children == nil {
+			children = signerNodes(aliasInfo.Result.Addresses)
+		}
+		nodes = append(nodes, model.OwnerNode{
+			Address:   owner,
+			Threshold: int8(threshold),
+			Owners:    children,
+		})
+		nested = true
+	}
+	if !nested {
+		return nil, nil
+	}
+
+	err := sortOwnerNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func signerNodes(addresses []string) []model.OwnerNode {
+	nodes := make([]model.OwnerNode, 0, len(addresses))
+	for _, addr := range addresses {
+		nodes = append(nodes, model.OwnerNode{Address: addr})
+	}
+	return nodes
+}
+
+func sortOwnerNodes(nodes []model.OwnerNode) error {
+	shortIds := make(map[string]ids.ShortID, len(nodes))
+	for i := range nodes {
+		shortId, err := address.ParseToID(nodes[i].Address)
+		if err != nil {
+			return ErrParsingAddress
+		}
+		shortIds[nodes[i].Address] = shortId
+		if len(nodes[i].Owners) > 0 {
+			if err = sortOwnerNodes(nodes[i].Owners); err != nil {
+				return err
+			}
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := shortIds[nodes[i].Address], shortIds[nodes[j].Address]
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+	return nil
+}
+
+// signerKeys returns the distinct signer keys of the tree in depth-first order
+func signerKeys(nodes []model.OwnerNode) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	walkSignerKeys(nodes, func(addr string) {
+		if !seen[addr] {
+			seen[addr] = true
+			keys = append(keys, addr)
+		}
+	})
+	return keys
+}
+
+// walkSignerKeys visits the signer keys of the tree in depth-first order. A key which owns several
+// nested aliases is visited once per alias.
+func walkSignerKeys(nodes []model.OwnerNode, visit func(addr string)) {
+	for _, node := range nodes {
+		if len(node.Owners) == 0 {
+			visit(node.Address)
+		} else {
+			walkSignerKeys(node.Owners, visit)
+		}
+	}
+}
+
+// evaluateOwnerTree marks the signer keys which have signed and the nested aliases whose threshold
+// has been reached as satisfied and returns the number of satisfied nodes of the given level
+func evaluateOwnerTree(nodes []model.OwnerNode, signers map[string]bool) int {
+	satisfied := 0
+	for i := range nodes {
+		node := &nodes[i]
+		if len(node.Owners) == 0 {
+			node.Satisfied = signers[node.Address]
+		} else {
+			node.Satisfied = evaluateOwnerTree(node.Owners, signers) >= int(node.Threshold)
+		}
+		if node.Satisfied {
+			satisfied++
+		}
+	}
+	return satisfied
+}
+
+// thresholdReached tells whether the given signers satisfy the threshold of the tx alias and, for
+// nested aliases, the thresholds of all aliases on the way to the signer keys
+func thresholdReached(multisigTx *model.MultisigTx, signers map[string]bool) bool {
+	if multisigTx.OwnerTree == nil {
+		return len(signers) >= int(multisigTx.Threshold)
+	}
+	return evaluateOwnerTree(multisigTx.OwnerTree, signers) >= int(multisigTx.Threshold)
+}
+
+// thresholdProgress returns the number of owners of the tx alias which are counted towards its threshold
+func thresholdProgress(multisigTx *model.MultisigTx) int {
+	signers := storedSigners(multisigTx)
+	if multisigTx.OwnerTree == nil {
+		return len(signers)
+	}
+	return evaluateOwnerTree(multisigTx.OwnerTree, signers)
+}
+
+// thresholdReachable tells whether the owners who have not rejected the tx can still reach its threshold
+func thresholdReachable(multisigTx *model.MultisigTx) bool {
+	candidates := make(map[string]bool)
+	for _, owner := range multisigTx.Owners {
+		if owner.Rejection == "" {
+			candidates[owner.Address] = true
+		}
+	}
+	// evaluating the tree marks its nodes, which must keep reflecting the stored signatures
+	reachable := *multisigTx
+	reachable.OwnerTree = cloneOwnerTree(multisigTx.OwnerTree)
+	return thresholdReached(&reachable, candidates)
+}
+
+func cloneOwnerTree(nodes []model.OwnerNode) []model.OwnerNode {
+	if nodes == nil {
+		return nil
+	}
+	clone := make([]model.OwnerNode, len(nodes))
+	for i, node := range nodes {
+		clone[i] = node
+		clone[i].Owners = cloneOwnerTree(node.Owners)
+	}
+	return clone
+}
+
+// storedSigners returns the owners of the tx which have signed
+func storedSigners(multisigTx *model.MultisigTx) map[string]bool {
+	signers := make(map[string]bool)
+	for _, owner := range multisigTx.Owners {
+		if owner.Signature != "" {
+			signers[owner.Address] = true
+		}
+	}
+	return signers
+}
+
+// topLevelOwners returns the owners of the tx alias itself, i.e. the signer keys and the nested aliases
+func topLevelOwners(multisigTx *model.MultisigTx) []string {
+	if multisigTx.OwnerTree == nil {
+		owners := make([]string, 0, len(multisigTx.Owners))
+		for _, owner := range multisigTx.Owners {
+			owners = append(owners, owner.Address)
+		}
+		return owners
+	}
+	owners := make([]string, 0, len(multisigTx.OwnerTree))
+	for _, node := range multisigTx.OwnerTree {
+		owners = append(owners, node.Address)
+	}
+	return owners
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	treeAlias       = "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	treeNestedAlias = "P-kopernikus1fq0jc8svlyazhygkj0s36qnl6s0km0h3uuc99w"
+	treeKeyA        = "P-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"
+	treeKeyB        = "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3"
+	treeKeyC        = "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh"
+)
+
+func nestedAliasTree() []model.OwnerNode {
+	return []model.OwnerNode{
+		{Address: treeKeyA},
+		{
+			Address:   treeNestedAlias,
+			Threshold: 1,
+			Owners:    []model.OwnerNode{{Address: treeKeyB}, {Address: treeKeyC}},
+		},
+	}
+}
+
+func TestResolveOwnerTree(t *testing.T) {
+	tests := []struct {
+		name   string
+		owners []string
+		mockFn func(mockNodeService *MockNodeService)
+		want   []model.OwnerNode
+		err    error
+	}{
+		{
+			name:   "Signer keys only",
+			owners: []string{treeKeyA, treeKeyB},
+			mockFn: func(mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).Times(2)
+			},
+			want: nil,
+		},
+		{
+			name:   "Nested alias",
+			owners: []string{treeKeyA, treeNestedAlias},
+			mockFn: func(mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(treeNestedAlias).Return(aliasInfoOf(model.MultisigTx{
+					Owners: []model.MultisigTxOwner{{Address: treeKeyB}, {Address: treeKeyC}},
+				}, 1), nil).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).Times(3)
+			},
+			want: nestedAliasTree(),
+		},
+		{
+			name:   "Cyclic alias",
+			owners: []string{treeKeyA, treeNestedAlias},
+			mockFn: func(mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(treeNestedAlias).Return(aliasInfoOf(model.MultisigTx{
+					Owners: []model.MultisigTxOwner{{Address: treeAlias}, {Address: treeKeyC}},
+				}, 1), nil).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+			},
+			err: ErrInvalidOwnerTree,
+		},
+		{
+			name:   "Node error",
+			owners: []string{treeKeyA, treeNestedAlias},
+			mockFn: func(mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetMultisigAlias(treeNestedAlias).Return(nil, errors.New("node error")).Times(1)
+				mockNodeService.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, errAliasInfoNotFound).AnyTimes()
+			},
+			err: errors.New("node error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNodeService := NewMockNodeService(ctrl)
+			tt.mockFn(mockNodeService)
+
+			got, err := resolveOwnerTree(mockNodeService, tt.owners, map[string]bool{treeAlias: true}, 1)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestThresholdReached(t *testing.T) {
+	tests := []struct {
+		name          string
+		signers       map[string]bool
+		want          bool
+		wantSatisfied []bool // of the key and the nested alias
+	}{
+		{
+			name:          "Only the key has signed",
+			signers:       map[string]bool{treeKeyA: true},
+			want:          false,
+			wantSatisfied: []bool{true, false},
+		},
+		{
+			name:          "Only the nested alias is satisfied",
+			signers:       map[string]bool{treeKeyB: true, treeKeyC: true},
+			want:          false,
+			wantSatisfied: []bool{false, true},
+		},
+		{
+			name:          "Key and nested alias are satisfied",
+			signers:       map[string]bool{treeKeyA: true, treeKeyC: true},
+			want:          true,
+			wantSatisfied: []bool{true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multisigTx := &model.MultisigTx{
+				Alias:     treeAlias,
+				Threshold: 2,
+				OwnerTree: nestedAliasTree(),
+			}
+			require.Equal(t, tt.want, thresholdReached(multisigTx, tt.signers))
+			for i, satisfied := range tt.wantSatisfied {
+				require.Equal(t, satisfied, multisigTx.OwnerTree[i].Satisfied)
+			}
+		})
+	}
+}
+
+func TestThresholdReachable(t *testing.T) {
+	tests := []struct {
+		name      string
+		rejecters []string
+		want      bool
+	}{
+		{
+			name:      "One key of the nested alias has rejected",
+			rejecters: []string{treeKeyB},
+			want:      true,
+		},
+		{
+			name:      "All keys of the nested alias have rejected",
+			rejecters: []string{treeKeyB, treeKeyC},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multisigTx := &model.MultisigTx{
+				Alias:     treeAlias,
+				Threshold: 2,
+				OwnerTree: nestedAliasTree(),
+				Owners:    []model.MultisigTxOwner{{Address: treeKeyA}, {Address: treeKeyB}, {Address: treeKeyC}},
+			}
+			for i := range multisigTx.Owners {
+				for _, rejecter := range tt.rejecters {
+					if multisigTx.Owners[i].Address == rejecter {
+						multisigTx.Owners[i].Rejection = "rejection"
+					}
+				}
+			}
+			require.Equal(t, tt.want, thresholdReachable(multisigTx))
+			// the tree keeps reflecting the stored signatures
+			require.Equal(t, nestedAliasTree(), multisigTx.OwnerTree)
+		})
+	}
+}
+
+func TestGetSortedOwnerSignaturesOfOwnerTree(t *testing.T) {
+	multisigTx := &model.MultisigTx{
+		Alias:     treeAlias,
+		Threshold: 2,
+		OwnerTree: nestedAliasTree(),
+		Owners: []model.MultisigTxOwner{
+			{Address: treeKeyC, Signature: "0c"},
+			{Address: treeKeyA, Signature: "0a"},
+			{Address: treeKeyB},
+		},
+	}
+
+	s := &multisigService{}
+	signatures, err := s.getSortedOwnerSignatures(multisigTx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{0x0a}, {}, {0x0c}}, signatures)
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+var (
+	ErrParentTxNotExists    = errors.New("parent transaction does not exist")
+	ErrParentTxFailed       = errors.New("parent transaction has been cancelled, rejected or has expired")
+	ErrParentTxNotCommitted = errors.New("parent transaction has not been committed yet")
+)
+
+// getParentTxState resolves the parent transaction of a tx, which is either the id of a stored multisig tx or
+// the id of a tx on chain. The status of a tx on chain is mapped to the corresponding multisig tx state.
+func (s *multisigService) getParentTxState(parentTransaction string) (model.MultisigTxState, error) {
+	parentTx, err := s.GetMultisigTxIgnoreState(parentTransaction)
+	if err == nil {
+		return parentTx.State, nil
+	}
+	if err != ErrTxNotExists {
+		return "", err
+	}
+
+	txID, err := ids.FromString(parentTransaction)
+	if err != nil {
+		return "", ErrParentTxNotExists
+	}
+	resp, err := s.nodeService.GetTxStatus(txID)
+	if err != nil {
+		return "", err
+	}
+	switch resp.Status {
+	case status.Committed:
+		return model.MultisigTxStateCommitted, nil
+	case status.Processing:
+		return model.MultisigTxStateIssued, nil
+	case status.Aborted, status.Dropped:
+		return model.MultisigTxStateRejected, nil
+	default:
+		return "", ErrParentTxNotExists
+	}
+}
+
+// verifyParentTx makes sure that the parent of a new tx exists and can still be committed
+func (s *multisigService) verifyParentTx(parentTransaction string) error {
+	state, err := s.getParentTxState(parentTransaction)
+	if err != nil {
+		return err
+	}
+	if isFailedState(state) {
+		return ErrParentTxFailed
+	}
+	return nil
+}
+
+// verifyParentTxCommitted makes sure that the parent of a tx, if there is one, has been committed before the tx
+// is issued
+func (s *multisigService) verifyParentTxCommitted(multisigTx *model.MultisigTx) error {
+	if multisigTx.ParentTransaction == "" {
+		return nil
+	}
+	state, err := s.getParentTxState(multisigTx.ParentTransaction)
+	if err != nil {
+		return err
+	}
+	switch {
+	case state == model.MultisigTxStateCommitted:
+		return nil
+	case isFailedState(state):
+		return ErrParentTxFailed
+	default:
+		return ErrParentTxNotCommitted
+	}
+}
+
+// parentReferences returns the references by which children can refer to a tx: its id and, once it has been
+// issued, its transaction id
+func parentReferences(multisigTx *model.MultisigTx) []string {
+	references := []string{multisigTx.Id}
+	if multisigTx.TransactionId != "" {
+		references = append(references, multisigTx.TransactionId)
+	}
+	return references
+}
+
+// invalidateChildTxs moves the active children of a tx which cannot be committed anymore to the invalidated
+// state, and their children in turn. A failure is only logged as the state of the parent has already been changed.
+func invalidateChildTxs(multisigTxDao dao.MultisigTxDao, parent *model.MultisigTx) {
+	childIds, err := multisigTxDao.GetChildTxIds(parentReferences(parent))
+	if err != nil {
+		log.Printf("Getting children of multisig tx %s failed: %v", parent.Id, err)
+		return
+	}
+
+	reason := fmt.Sprintf("parent tx %s has been %s", parent.Id, parent.State)
+	for _, childId := range childIds {
+		children, err := multisigTxDao.GetMultisigTx(childId, "", "", true)
+		if err != nil {
+			log.Printf("Getting child multisig tx %s failed: %v", childId, err)
+			continue
+		}
+		// only active children can be invalidated
+		if children == nil || len(*children) == 0 {
+			continue
+		}
+
+		child := &(*children)[0]
+		err = validateStateTransition(child.State, model.MultisigTxStateInvalidated)
+		if err != nil {
+			log.Printf("multisig tx %s cannot be moved from %s to %s: %v", child.Id, child.State, model.MultisigTxStateInvalidated, err)
+			continue
+		}
+		updated, err := multisigTxDao.UpdateStateWithReason(child.Id, child.State, model.MultisigTxStateInvalidated, reason)
+		if err != nil {
+			log.Printf("Invalidating child multisig tx %s failed: %v", child.Id, err)
+			continue
+		}
+		if !updated {
+			continue
+		}
+		child.State = model.MultisigTxStateInvalidated
+		invalidateChildTxs(multisigTxDao, child)
+	}
+}
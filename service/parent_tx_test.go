@@ -0,0 +1,138 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyParentTxCommitted(t *testing.T) {
+	parentId := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+	onChainId := ids.GenerateTestID()
+
+	tests := []struct {
+		name              string
+		parentTransaction string
+		mockFn            func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService)
+		err               error
+	}{
+		{
+			name:              "No parent",
+			parentTransaction: "",
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:              "Stored parent has been committed",
+			parentTransaction: parentId,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(parentId, "", "", false).Return(&[]model.MultisigTx{{Id: parentId, State: model.MultisigTxStateCommitted}}, nil).Times(1)
+			},
+		},
+		{
+			name:              "Stored parent is pending",
+			parentTransaction: parentId,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(parentId, "", "", false).Return(&[]model.MultisigTx{{Id: parentId, State: model.MultisigTxStatePending}}, nil).Times(1)
+			},
+			err: ErrParentTxNotCommitted,
+		},
+		{
+			name:              "Stored parent has been cancelled",
+			parentTransaction: parentId,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(parentId, "", "", false).Return(&[]model.MultisigTx{{Id: parentId, State: model.MultisigTxStateCancelled}}, nil).Times(1)
+			},
+			err: ErrParentTxFailed,
+		},
+		{
+			name:              "Parent on chain has been committed",
+			parentTransaction: onChainId.String(),
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(onChainId.String(), "", "", false).Return(nil, nil).Times(1)
+				mockNodeService.EXPECT().GetTxStatus(onChainId).Return(&platformvm.GetTxStatusResponse{Status: status.Committed}, nil).Times(1)
+			},
+		},
+		{
+			name:              "Parent on chain is processing",
+			parentTransaction: onChainId.String(),
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(onChainId.String(), "", "", false).Return(nil, nil).Times(1)
+				mockNodeService.EXPECT().GetTxStatus(onChainId).Return(&platformvm.GetTxStatusResponse{Status: status.Processing}, nil).Times(1)
+			},
+			err: ErrParentTxNotCommitted,
+		},
+		{
+			name:              "Parent unknown on chain",
+			parentTransaction: onChainId.String(),
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(onChainId.String(), "", "", false).Return(nil, nil).Times(1)
+				mockNodeService.EXPECT().GetTxStatus(onChainId).Return(&platformvm.GetTxStatusResponse{Status: status.Unknown}, nil).Times(1)
+			},
+			err: ErrParentTxNotExists,
+		},
+		{
+			name:              "Parent is neither stored nor a tx id",
+			parentTransaction: parentId,
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockDao.EXPECT().GetMultisigTx(parentId, "", "", false).Return(nil, nil).Times(1)
+				mockNodeService.EXPECT().GetTxStatus(gomock.Any()).Times(0)
+			},
+			err: ErrParentTxNotExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+			mockNodeService := NewMockNodeService(ctrl)
+			tt.mockFn(mockDao, mockNodeService)
+
+			s := &multisigService{
+				config:      &util.Config{NetworkId: networkId},
+				dao:         mockDao,
+				nodeService: mockNodeService,
+			}
+			err := s.verifyParentTxCommitted(&model.MultisigTx{Id: "child", ParentTransaction: tt.parentTransaction})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestInvalidateChildTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+	parent := &model.MultisigTx{Id: "parent", TransactionId: "transaction", State: model.MultisigTxStateRejected}
+	child := model.MultisigTx{Id: "child", State: model.MultisigTxStatePending}
+	grandchild := model.MultisigTx{Id: "grandchild", State: model.MultisigTxStateThresholdReached}
+
+	gomock.InOrder(
+		mockDao.EXPECT().GetChildTxIds([]string{"parent", "transaction"}).Return([]string{"child", "issuedChild"}, nil).Times(1),
+		mockDao.EXPECT().GetMultisigTx("child", "", "", true).Return(&[]model.MultisigTx{child}, nil).Times(1),
+		mockDao.EXPECT().UpdateStateWithReason("child", model.MultisigTxStatePending, model.MultisigTxStateInvalidated,
+			"parent tx parent has been rejected").Return(true, nil).Times(1),
+		mockDao.EXPECT().GetChildTxIds([]string{"child"}).Return([]string{"grandchild"}, nil).Times(1),
+		mockDao.EXPECT().GetMultisigTx("grandchild", "", "", true).Return(&[]model.MultisigTx{grandchild}, nil).Times(1),
+		mockDao.EXPECT().UpdateStateWithReason("grandchild", model.MultisigTxStateThresholdReached, model.MultisigTxStateInvalidated,
+			"parent tx child has been invalidated").Return(true, nil).Times(1),
+		mockDao.EXPECT().GetChildTxIds([]string{"grandchild"}).Return([]string{}, nil).Times(1),
+		// children which are not active anymore are left as they are
+		mockDao.EXPECT().GetMultisigTx("issuedChild", "", "", true).Return(nil, nil).Times(1),
+	)
+
+	invalidateChildTxs(mockDao, parent)
+}
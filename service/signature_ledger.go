@@ -0,0 +1,198 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+const ledgerVerifyBatchSize = 1000
+
+// GetSignatureProof returns the entries of the signature ledger from the first signature of the tx up to the head
+// of the ledger, so that the signatures of the tx can be linked to a head which has been obtained before. The
+// request has to be signed by an owner of the tx.
+func (s *multisigService) GetSignatureProof(id string, timestamp string, signature string) (*dto.LedgerProof, error) {
+	_, err := s.GetMultisigTxForOwner(id, timestamp, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// the head is read first, entries which have been chained in the meantime are not part of the proof
+	head, err := s.dao.GetLedgerHead()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.dao.GetLedgerProofEntries(id)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get signature ledger entries of tx %s: %w", id, err)
+	}
+
+	steps := make([]dto.LedgerProofStep, 0, len(*entries))
+	for i := range *entries {
+		entry := &(*entries)[i]
+		step := dto.LedgerProofStep{
+			Id:          entry.Id,
+			PrevHash:    entry.PrevHash,
+			ContentHash: entry.ContentHash(),
+			Hash:        entry.Hash,
+		}
+		if entry.Table == model.LedgerTableMultisigTxOwners && entry.RecordId == id {
+			step.Entry = entry
+		}
+		steps = append(steps, step)
+		if entry.Hash == head {
+			break
+		}
+	}
+	return &dto.LedgerProof{
+		MultisigTxId: id,
+		Head:         head,
+		Steps:        steps,
+	}, nil
+}
+
+// LedgerVerifier replays the signature ledger and compares the resulting signatures with the stored ones
+type LedgerVerifier struct {
+	dao dao.MultisigTxDao
+}
+
+func NewLedgerVerifier(dao dao.MultisigTxDao) *LedgerVerifier {
+	return &LedgerVerifier{
+		dao: dao,
+	}
+}
+
+type ledgerRecord struct {
+	table    string
+	recordId string
+}
+
+// ledgerState holds the signatures per address of every record
+type ledgerState map[ledgerRecord]map[string]string
+
+func (l ledgerState) apply(entry *model.LedgerEntry) {
+	key := ledgerRecord{table: entry.Table, recordId: entry.RecordId}
+	switch entry.Operation {
+	case model.LedgerOperationSign:
+		if l[key] == nil {
+			l[key] = make(map[string]string)
+		}
+		l[key][entry.Address] = entry.Signature
+	case model.LedgerOperationWithdraw:
+		delete(l[key], entry.Address)
+	case model.LedgerOperationDelete:
+		delete(l, key)
+	}
+}
+
+// Verify checks that every entry of the ledger is linked to the previous one and matches its hash, and that the
+// stored signatures are the ones resulting from the ledger. Every deviation is reported as a problem.
+func (v *LedgerVerifier) Verify() (*dto.LedgerReport, error) {
+	report, state, err := v.replay()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := v.dao.GetStoredSignatures()
+	if err != nil {
+		return nil, err
+	}
+	for _, signature := range *stored {
+		key := ledgerRecord{table: signature.Table, recordId: signature.RecordId}
+		ledgerSignature, ok := state[key][signature.Address]
+		switch {
+		case !ok:
+			report.Problems = append(report.Problems, fmt.Sprintf("signature of %s for %s %s is not in the ledger", signature.Address, signature.Table, signature.RecordId))
+		case ledgerSignature != signature.Signature:
+			report.Problems = append(report.Problems, fmt.Sprintf("signature of %s for %s %s differs from the ledger", signature.Address, signature.Table, signature.RecordId))
+		}
+		delete(state[key], signature.Address)
+	}
+
+	missing := make([]string, 0)
+	for key, signatures := range state {
+		for address := range signatures {
+			missing = append(missing, fmt.Sprintf("signature of %s for %s %s is in the ledger but not stored", address, key.table, key.recordId))
+		}
+	}
+	sort.Strings(missing)
+	report.Problems = append(report.Problems, missing...)
+	return report, nil
+}
+
+// Seed chains the stored signatures which are not in the ledger yet, e.g. the ones stored before the ledger was
+// introduced. It returns the number of chained signatures.
+func (v *LedgerVerifier) Seed() (int, error) {
+	_, state, err := v.replay()
+	if err != nil {
+		return 0, err
+	}
+
+	stored, err := v.dao.GetStoredSignatures()
+	if err != nil {
+		return 0, err
+	}
+	entries := make([]*model.LedgerEntry, 0)
+	for i, signature := range *stored {
+		if _, ok := state[ledgerRecord{table: signature.Table, recordId: signature.RecordId}][signature.Address]; !ok {
+			entries = append(entries, &(*stored)[i])
+		}
+	}
+	err = v.dao.AppendLedgerEntries(entries)
+	if err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// replay reads the whole ledger in batches, checks the links and hashes of its entries and returns the resulting
+// signatures
+func (v *LedgerVerifier) replay() (*dto.LedgerReport, ledgerState, error) {
+	head, err := v.dao.GetLedgerHead()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &dto.LedgerReport{
+		Head:     head,
+		Problems: make([]string, 0),
+	}
+	state := make(ledgerState)
+	prevHash := model.LedgerGenesisHash
+	var lastId int64
+	for {
+		entries, err := v.dao.GetLedgerEntries(lastId, ledgerVerifyBatchSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range *entries {
+			entry := &(*entries)[i]
+			if entry.PrevHash != prevHash {
+				report.Problems = append(report.Problems, fmt.Sprintf("entry %d is not linked to the previous entry", entry.Id))
+			}
+			hash, err := entry.ComputeHash()
+			if err != nil || hash != entry.Hash {
+				report.Problems = append(report.Problems, fmt.Sprintf("entry %d does not match its hash", entry.Id))
+			}
+			state.apply(entry)
+			prevHash = entry.Hash
+			lastId = entry.Id
+			report.Entries++
+		}
+		if len(*entries) < ledgerVerifyBatchSize {
+			break
+		}
+	}
+	if prevHash != head {
+		report.Problems = append(report.Problems, fmt.Sprintf("head %s is not the hash of the last entry", head))
+	}
+	return report, state, nil
+}
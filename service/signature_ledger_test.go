@@ -0,0 +1,208 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// chainLedgerEntries links the entries to each other starting from the genesis hash
+func chainLedgerEntries(t *testing.T, entries ...model.LedgerEntry) []model.LedgerEntry {
+	prevHash := model.LedgerGenesisHash
+	for i := range entries {
+		entries[i].Id = int64(i + 1)
+		entries[i].PrevHash = prevHash
+		hash, err := entries[i].ComputeHash()
+		require.NoError(t, err)
+		entries[i].Hash = hash
+		prevHash = hash
+	}
+	return entries
+}
+
+func TestGetSignatureProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNodeService := NewMockNodeService(ctrl)
+	mockDao := dao.NewMockMultisigTxDao(ctrl)
+	mockConfig := &util.Config{
+		NetworkId: networkId,
+	}
+
+	// signature of owner P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh for id + timestamp
+	requestSignature := "35761f51218361013de47fcc3e1d72e0508a4d2112493c2cdd2318bdb26834740268ade1861903efbd25fc5bb9354618044abbb2f66a7aac8119e353faf242e001"
+	timestamp := "1678877386"
+	id := "b62c43e3522eec9891723220785711274a979f295a11dd58156080ea462db5ac"
+	owner := "P-kopernikus1l3e9pgs3mmwuwrh95fecme0s0qtn2880kcjxnh"
+
+	mockTx := model.MultisigTx{
+		Id:    id,
+		Alias: "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+		State: model.MultisigTxStatePending,
+		Owners: []model.MultisigTxOwner{
+			{
+				MultisigTxId: id,
+				Address:      owner,
+				Signature:    "signature",
+			},
+		},
+	}
+	entries := chainLedgerEntries(t,
+		model.LedgerEntry{Operation: model.LedgerOperationSign, Table: model.LedgerTableMultisigTxOwners, RecordId: id, Address: owner, Signature: "signature"},
+		model.LedgerEntry{Operation: model.LedgerOperationSign, Table: model.LedgerTableDepositOfferSigs, RecordId: "depositOffer", Address: "address", Signature: "signature"},
+		model.LedgerEntry{Operation: model.LedgerOperationWithdraw, Table: model.LedgerTableMultisigTxOwners, RecordId: id, Address: owner},
+		model.LedgerEntry{Operation: model.LedgerOperationSign, Table: model.LedgerTableMultisigTxOwners, RecordId: "other", Address: owner, Signature: "signature"},
+	)
+
+	mockDao.EXPECT().GetMultisigTx(id, "", "", false).Return(&[]model.MultisigTx{mockTx}, nil).Times(2)
+	// the last entry has been chained after the head has been read
+	mockDao.EXPECT().GetLedgerHead().Return(entries[2].Hash, nil).Times(1)
+	mockDao.EXPECT().GetLedgerProofEntries(id).Return(&entries, nil).Times(1)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		want      *dto.LedgerProof
+		err       error
+	}{
+		{
+			name:      "Get signature proof",
+			timestamp: timestamp,
+			want: &dto.LedgerProof{
+				MultisigTxId: id,
+				Head:         entries[2].Hash,
+				Steps: []dto.LedgerProofStep{
+					{Id: 1, PrevHash: entries[0].PrevHash, ContentHash: entries[0].ContentHash(), Hash: entries[0].Hash, Entry: &entries[0]},
+					{Id: 2, PrevHash: entries[1].PrevHash, ContentHash: entries[1].ContentHash(), Hash: entries[1].Hash},
+					{Id: 3, PrevHash: entries[2].PrevHash, ContentHash: entries[2].ContentHash(), Hash: entries[2].Hash, Entry: &entries[2]},
+				},
+			},
+		},
+		{
+			name:      "Get signature proof - not an owner",
+			timestamp: "1678877387",
+			err:       ErrAddressNotOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMultisigService(mockConfig, mockDao, mockNodeService, &recordingEventPublisher{}, nil)
+			got, err := s.GetSignatureProof(id, tt.timestamp, requestSignature)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLedgerVerifier(t *testing.T) {
+	signature := func(table string, recordId string, address string, signature string) model.LedgerEntry {
+		return model.LedgerEntry{Operation: model.LedgerOperationSign, Table: table, RecordId: recordId, Address: address, Signature: signature}
+	}
+	ledger := func(t *testing.T) []model.LedgerEntry {
+		return chainLedgerEntries(t,
+			signature(model.LedgerTableMultisigTxOwners, "1", "address1", "signature1"),
+			signature(model.LedgerTableMultisigTxOwners, "1", "address2", "signature2"),
+			model.LedgerEntry{Operation: model.LedgerOperationWithdraw, Table: model.LedgerTableMultisigTxOwners, RecordId: "1", Address: "address2"},
+			signature(model.LedgerTableMultisigTxOwners, "2", "address1", "signature1"),
+			model.LedgerEntry{Operation: model.LedgerOperationDelete, Table: model.LedgerTableMultisigTxOwners, RecordId: "2"},
+			signature(model.LedgerTableDepositOfferSigs, "depositOffer", "address1", "signature1"),
+		)
+	}
+	stored := []model.LedgerEntry{
+		signature(model.LedgerTableMultisigTxOwners, "1", "address1", "signature1"),
+		signature(model.LedgerTableDepositOfferSigs, "depositOffer", "address1", "signature1"),
+	}
+
+	tests := []struct {
+		name   string
+		tamper func(entries []model.LedgerEntry) []model.LedgerEntry
+		stored []model.LedgerEntry
+		want   []string
+	}{
+		{
+			name:   "Verify ledger",
+			stored: stored,
+			want:   []string{},
+		},
+		{
+			name: "Verify ledger - altered entry",
+			tamper: func(entries []model.LedgerEntry) []model.LedgerEntry {
+				entries[1].Signature = "altered"
+				return entries
+			},
+			stored: stored,
+			want:   []string{"entry 2 does not match its hash"},
+		},
+		{
+			name: "Verify ledger - deleted entry",
+			tamper: func(entries []model.LedgerEntry) []model.LedgerEntry {
+				return append(entries[:2], entries[3:]...)
+			},
+			stored: stored,
+			want: []string{
+				"entry 4 is not linked to the previous entry",
+				"signature of address2 for multisig_tx_owners 1 is in the ledger but not stored",
+			},
+		},
+		{
+			name: "Verify ledger - altered signatures",
+			stored: []model.LedgerEntry{
+				signature(model.LedgerTableMultisigTxOwners, "1", "address1", "altered"),
+				signature(model.LedgerTableMultisigTxOwners, "1", "address2", "signature2"),
+			},
+			want: []string{
+				"signature of address1 for multisig_tx_owners 1 differs from the ledger",
+				"signature of address2 for multisig_tx_owners 1 is not in the ledger",
+				"signature of address1 for deposit_offer_sigs depositOffer is in the ledger but not stored",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+			entries := ledger(t)
+			head := entries[len(entries)-1].Hash
+			if tt.tamper != nil {
+				entries = tt.tamper(entries)
+			}
+			mockDao.EXPECT().GetLedgerHead().Return(head, nil).Times(1)
+			mockDao.EXPECT().GetLedgerEntries(int64(0), ledgerVerifyBatchSize).Return(&entries, nil).Times(1)
+			mockDao.EXPECT().GetStoredSignatures().Return(&tt.stored, nil).Times(1)
+
+			got, err := NewLedgerVerifier(mockDao).Verify()
+			require.NoError(t, err)
+			require.Equal(t, &dto.LedgerReport{
+				Entries:  len(entries),
+				Head:     head,
+				Problems: tt.want,
+			}, got)
+		})
+	}
+
+	t.Run("Seed ledger", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+		entries := ledger(t)
+		storedBeforeLedger := append([]model.LedgerEntry{signature(model.LedgerTableMultisigTxOwners, "3", "address1", "signature1")}, stored...)
+		mockDao.EXPECT().GetLedgerHead().Return(entries[len(entries)-1].Hash, nil).Times(1)
+		mockDao.EXPECT().GetLedgerEntries(int64(0), ledgerVerifyBatchSize).Return(&entries, nil).Times(1)
+		mockDao.EXPECT().GetStoredSignatures().Return(&storedBeforeLedger, nil).Times(1)
+		mockDao.EXPECT().AppendLedgerEntries([]*model.LedgerEntry{&storedBeforeLedger[0]}).Return(nil).Times(1)
+
+		got, err := NewLedgerVerifier(mockDao).Seed()
+		require.NoError(t, err)
+		require.Equal(t, 1, got)
+	})
+}
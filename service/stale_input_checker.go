@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const defaultStaleInputCheckInterval = 60 * time.Second
+
+// StaleInputChecker periodically resolves the inputs of active multisig txs against the utxos of their alias
+// and moves the txs whose inputs have been spent elsewhere to the stale state, as they cannot be issued anymore.
+type StaleInputChecker interface {
+	Start(ctx context.Context)
+}
+
+type staleInputChecker struct {
+	config      *util.Config
+	dao         dao.MultisigTxDao
+	nodeService NodeService
+}
+
+func NewStaleInputChecker(config *util.Config, dao dao.MultisigTxDao, nodeService NodeService) StaleInputChecker {
+	return &staleInputChecker{
+		config:      config,
+		dao:         dao,
+		nodeService: nodeService,
+	}
+}
+
+func (c *staleInputChecker) Start(ctx context.Context) {
+	interval := defaultStaleInputCheckInterval
+	if c.config.StaleInputCheckInterval > 0 {
+		interval = time.Duration(c.config.StaleInputCheckInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.checkActiveTxs(); err != nil {
+					log.Printf("failed to check inputs of active multisig txs: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (c *staleInputChecker) checkActiveTxs() error {
+	activeTxs, err := c.dao.GetActiveTx()
+	if err != nil {
+		return err
+	}
+	if activeTxs == nil {
+		return nil
+	}
+
+	// the utxos of an alias are only fetched once per run
+	utxosByAlias := make(map[string]map[ids.ID]struct{})
+	for _, tx := range *activeTxs {
+		inputIds, err := getLocalInputIds(tx.UnsignedTx)
+		if err != nil {
+			log.Printf("failed to parse inputs of multisig tx %s: %v", tx.Id, err)
+			continue
+		}
+		if len(inputIds) == 0 {
+			continue
+		}
+
+		utxos, ok := utxosByAlias[tx.Alias]
+		if !ok {
+			utxoIds, err := c.nodeService.GetUTXOIds(tx.Alias)
+			if err != nil {
+				log.Printf("failed to get utxos of alias %s: %v", tx.Alias, err)
+				continue
+			}
+			utxos = make(map[ids.ID]struct{}, len(utxoIds))
+			for _, utxoId := range utxoIds {
+				utxos[utxoId] = struct{}{}
+			}
+			utxosByAlias[tx.Alias] = utxos
+		}
+
+		var spentInput *ids.ID
+		for i := range inputIds {
+			if _, ok := utxos[inputIds[i]]; !ok {
+				spentInput = &inputIds[i]
+				break
+			}
+		}
+		if spentInput == nil {
+			continue
+		}
+
+		log.Printf("input %s of multisig tx %s has been spent, marking tx as stale", spentInput, tx.Id)
+		err = validateStateTransition(tx.State, model.MultisigTxStateStale)
+		if err != nil {
+			log.Printf("multisig tx %s cannot be moved from %s to %s: %v", tx.Id, tx.State, model.MultisigTxStateStale, err)
+			continue
+		}
+		// the update does nothing if the tx has been issued or cancelled in the meantime
+		_, err = c.dao.UpdateState(tx.Id, tx.State, model.MultisigTxStateStale)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getLocalInputIds returns the ids of the P-chain utxos consumed by the unsigned tx. Imported inputs are
+// not part of the P-chain utxo set and are therefore ignored.
+func getLocalInputIds(txHexString string) ([]ids.ID, error) {
+	var unsignedTx txs.UnsignedTx
+	_, err := txs.Codec.Unmarshal(common.FromHex(txHexString), &unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputIds := make([]ids.ID, 0)
+	walkTx(reflect.ValueOf(unsignedTx), func(v reflect.Value) bool {
+		if v.Type() != avaxBaseTxType {
+			return false
+		}
+		baseTx := v.Interface().(avax.BaseTx)
+		for _, in := range baseTx.Ins {
+			inputIds = append(inputIds, in.InputID())
+		}
+		return true
+	})
+	return inputIds, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckActiveTxs(t *testing.T) {
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	utxo := avax.UTXOID{TxID: ids.ID{1}, OutputIndex: 0}
+	otherUtxo := avax.UTXOID{TxID: ids.ID{2}, OutputIndex: 1}
+
+	var unsignedTx txs.UnsignedTx = &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID: networkId,
+			Ins: []*avax.TransferableInput{
+				{
+					UTXOID: utxo,
+					In:     &secp256k1fx.TransferInput{Amt: 1000},
+				},
+			},
+		},
+	}
+	txBytes, err := txs.Codec.Marshal(txs.Version, &unsignedTx)
+	require.NoError(t, err)
+	unsignedTxHex := fmt.Sprintf("%x", txBytes)
+
+	tests := []struct {
+		name     string
+		storedTx model.MultisigTx
+		mockFn   func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService)
+		wantErr  bool
+	}{
+		{
+			name:     "Unspent inputs",
+			storedTx: model.MultisigTx{Id: "1", UnsignedTx: unsignedTxHex, Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetUTXOIds(alias).Return([]ids.ID{otherUtxo.InputID(), utxo.InputID()}, nil).Times(1)
+				mockDao.EXPECT().UpdateState(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Spent input",
+			storedTx: model.MultisigTx{Id: "1", UnsignedTx: unsignedTxHex, Alias: alias, State: model.MultisigTxStateThresholdReached},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetUTXOIds(alias).Return([]ids.ID{otherUtxo.InputID()}, nil).Times(1)
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStateThresholdReached, model.MultisigTxStateStale).Return(true, nil).Times(1)
+			},
+		},
+		{
+			name:     "Unparsable tx",
+			storedTx: model.MultisigTx{Id: "1", UnsignedTx: "invalid", Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetUTXOIds(gomock.Any()).Times(0)
+				mockDao.EXPECT().UpdateState(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Node error",
+			storedTx: model.MultisigTx{Id: "1", UnsignedTx: unsignedTxHex, Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetUTXOIds(alias).Return(nil, errors.New("node error")).Times(1)
+				mockDao.EXPECT().UpdateState(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Dao error",
+			storedTx: model.MultisigTx{Id: "1", UnsignedTx: unsignedTxHex, Alias: alias, State: model.MultisigTxStatePending},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetUTXOIds(alias).Return([]ids.ID{}, nil).Times(1)
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStatePending, model.MultisigTxStateStale).Return(false, errors.New("dao error")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNodeService := NewMockNodeService(ctrl)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+			mockDao.EXPECT().GetActiveTx().Return(&[]model.MultisigTx{tt.storedTx}, nil).Times(1)
+			tt.mockFn(mockDao, mockNodeService)
+
+			checker := &staleInputChecker{
+				config:      &util.Config{},
+				dao:         mockDao,
+				nodeService: mockNodeService,
+			}
+			err := checker.checkActiveTxs()
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
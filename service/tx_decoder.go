@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+var (
+	avaxBaseTxType      = reflect.TypeOf(avax.BaseTx{})
+	transferableInType  = reflect.TypeOf(avax.TransferableInput{})
+	transferableOutType = reflect.TypeOf(avax.TransferableOutput{})
+	outputOwnersType    = reflect.TypeOf(secp256k1fx.OutputOwners{})
+	lockedIDsType       = reflect.TypeOf(locked.IDs{})
+)
+
+// decodeUnsignedTx describes the unsigned tx in a human-readable form. Inputs and outputs are collected from
+// all fields of the tx, e.g. the imported inputs of an ImportTx or the stake outputs of an AddValidatorTx.
+func decodeUnsignedTx(unsignedTx txs.UnsignedTx, networkId uint32) (*model.DecodedTx, error) {
+	hrp := constants.GetHRP(networkId)
+	decoded := &model.DecodedTx{
+		Type:    reflect.Indirect(reflect.ValueOf(unsignedTx)).Type().Name(),
+		Inputs:  []model.DecodedInput{},
+		Outputs: []model.DecodedOutput{},
+		Amounts: []model.DecodedAmount{},
+	}
+
+	var err error
+	walkTx(reflect.ValueOf(unsignedTx), func(v reflect.Value) bool {
+		if err != nil {
+			return true
+		}
+		switch v.Type() {
+		case avaxBaseTxType:
+			baseTx := v.Interface().(avax.BaseTx)
+			decoded.NetworkId = baseTx.NetworkID
+			decoded.BlockchainId = baseTx.BlockchainID.String()
+			decoded.Memo = string(baseTx.Memo)
+			return false
+		case transferableInType:
+			in := v.Interface().(avax.TransferableInput)
+			depositTxId, bondTxId := decodeLockedIDs(reflect.ValueOf(in.In))
+			decoded.Inputs = append(decoded.Inputs, model.DecodedInput{
+				TxId:        in.UTXOID.TxID.String(),
+				OutputIndex: in.UTXOID.OutputIndex,
+				AssetId:     in.Asset.ID.String(),
+				Amount:      in.In.Amount(),
+				DepositTxId: depositTxId,
+				BondTxId:    bondTxId,
+			})
+			return true
+		case transferableOutType:
+			out := v.Interface().(avax.TransferableOutput)
+			var decodedOut *model.DecodedOutput
+			decodedOut, err = decodeOutput(out, hrp)
+			if err == nil {
+				decoded.Outputs = append(decoded.Outputs, *decodedOut)
+			}
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	decoded.Amounts = sumAmounts(decoded.Inputs, decoded.Outputs)
+	// the type specific fields are taken as they are marshalled by the tx types
+	if details, err := json.Marshal(unsignedTx); err == nil {
+		decoded.Details = details
+	}
+	return decoded, nil
+}
+
+func decodeOutput(out avax.TransferableOutput, hrp string) (*model.DecodedOutput, error) {
+	depositTxId, bondTxId := decodeLockedIDs(reflect.ValueOf(out.Out))
+	decodedOut := &model.DecodedOutput{
+		AssetId:     out.Asset.ID.String(),
+		Amount:      out.Out.Amount(),
+		Addresses:   []string{},
+		DepositTxId: depositTxId,
+		BondTxId:    bondTxId,
+	}
+
+	var owners *secp256k1fx.OutputOwners
+	walkTx(reflect.ValueOf(out.Out), func(v reflect.Value) bool {
+		if v.Type() != outputOwnersType {
+			return false
+		}
+		o := v.Interface().(secp256k1fx.OutputOwners)
+		owners = &o
+		return true
+	})
+	if owners == nil {
+		return decodedOut, nil
+	}
+
+	decodedOut.Locktime = owners.Locktime
+	decodedOut.Threshold = owners.Threshold
+	for _, addr := range owners.Addrs {
+		formatted, err := address.Format("P", hrp, addr.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		decodedOut.Addresses = append(decodedOut.Addresses, formatted)
+	}
+	return decodedOut, nil
+}
+
+// decodeLockedIDs returns the deposit and bond tx ids of a locked input or output, if any
+func decodeLockedIDs(v reflect.Value) (string, string) {
+	var depositTxId, bondTxId string
+	walkTx(v, func(v reflect.Value) bool {
+		if v.Type() != lockedIDsType {
+			return false
+		}
+		lockIDs := v.Interface().(locked.IDs)
+		if lockIDs.DepositTxID != ids.Empty {
+			depositTxId = lockIDs.DepositTxID.String()
+		}
+		if lockIDs.BondTxID != ids.Empty {
+			bondTxId = lockIDs.BondTxID.String()
+		}
+		return true
+	})
+	return depositTxId, bondTxId
+}
+
+func sumAmounts(inputs []model.DecodedInput, outputs []model.DecodedOutput) []model.DecodedAmount {
+	amounts := make(map[string]*model.DecodedAmount)
+	get := func(assetId string) *model.DecodedAmount {
+		if _, ok := amounts[assetId]; !ok {
+			amounts[assetId] = &model.DecodedAmount{AssetId: assetId}
+		}
+		return amounts[assetId]
+	}
+	for _, in := range inputs {
+		get(in.AssetId).Consumed += in.Amount
+	}
+	for _, out := range outputs {
+		get(out.AssetId).Produced += out.Amount
+	}
+
+	result := make([]model.DecodedAmount, 0, len(amounts))
+	for _, amount := range amounts {
+		// txs like ClaimTx produce more than they consume
+		if amount.Consumed > amount.Produced {
+			amount.Burned = amount.Consumed - amount.Produced
+		}
+		result = append(result, *amount)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AssetId < result[j].AssetId
+	})
+	return result
+}
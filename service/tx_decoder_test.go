@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeUnsignedTx(t *testing.T) {
+	assetId := ids.ID{1}
+	inputTxId := ids.ID{2}
+	depositTxId := ids.ID{3}
+	owner := ids.ShortID{4}
+
+	unsignedTx := &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    networkId,
+			BlockchainID: ids.Empty,
+			Ins: []*avax.TransferableInput{
+				{
+					UTXOID: avax.UTXOID{TxID: inputTxId, OutputIndex: 1},
+					Asset:  avax.Asset{ID: assetId},
+					In: &secp256k1fx.TransferInput{
+						Amt:   1000,
+						Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			Outs: []*avax.TransferableOutput{
+				{
+					Asset: avax.Asset{ID: assetId},
+					Out: &locked.Out{
+						IDs: locked.IDs{DepositTxID: depositTxId},
+						TransferableOut: &secp256k1fx.TransferOutput{
+							Amt: 900,
+							OutputOwners: secp256k1fx.OutputOwners{
+								Threshold: 1,
+								Addrs:     []ids.ShortID{owner},
+							},
+						},
+					},
+				},
+			},
+			Memo: []byte("memo"),
+		},
+	}
+
+	ownerAddress, err := address.Format("P", constants.GetHRP(networkId), owner.Bytes())
+	require.NoError(t, err)
+
+	decoded, err := decodeUnsignedTx(unsignedTx, networkId)
+	require.NoError(t, err)
+	require.Equal(t, "BaseTx", decoded.Type)
+	require.Equal(t, networkId, decoded.NetworkId)
+	require.Equal(t, "memo", decoded.Memo)
+	require.Equal(t, []model.DecodedInput{
+		{
+			TxId:        inputTxId.String(),
+			OutputIndex: 1,
+			AssetId:     assetId.String(),
+			Amount:      1000,
+		},
+	}, decoded.Inputs)
+	require.Equal(t, []model.DecodedOutput{
+		{
+			AssetId:     assetId.String(),
+			Amount:      900,
+			Threshold:   1,
+			Addresses:   []string{ownerAddress},
+			DepositTxId: depositTxId.String(),
+		},
+	}, decoded.Outputs)
+	require.Equal(t, []model.DecodedAmount{
+		{
+			AssetId:  assetId.String(),
+			Consumed: 1000,
+			Produced: 900,
+			Burned:   100,
+		},
+	}, decoded.Amounts)
+	require.NotEmpty(t, decoded.Details)
+}
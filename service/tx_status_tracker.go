@@ -0,0 +1,133 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+)
+
+const defaultTxStatusPollInterval = 10 * time.Second
+
+// TxStatusTracker polls the P-chain for the status of issued multisig txs and moves them to the
+// committed or rejected state once the tx has been committed, aborted or dropped.
+type TxStatusTracker interface {
+	Start(ctx context.Context)
+}
+
+type txStatusTracker struct {
+	config      *util.Config
+	dao         dao.MultisigTxDao
+	nodeService NodeService
+	publisher   EventPublisher
+}
+
+func NewTxStatusTracker(config *util.Config, dao dao.MultisigTxDao, nodeService NodeService, publisher EventPublisher) TxStatusTracker {
+	return &txStatusTracker{
+		config:      config,
+		dao:         dao,
+		nodeService: nodeService,
+		publisher:   publisher,
+	}
+}
+
+func (t *txStatusTracker) Start(ctx context.Context) {
+	interval := defaultTxStatusPollInterval
+	if t.config.TxStatusPollInterval > 0 {
+		interval = time.Duration(t.config.TxStatusPollInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := t.trackIssuedTxs(); err != nil {
+					log.Printf("failed to track issued multisig txs: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (t *txStatusTracker) trackIssuedTxs() error {
+	issuedTxs, err := t.dao.GetUnsettledIssuedTx()
+	if err != nil {
+		return err
+	}
+	if issuedTxs == nil {
+		return nil
+	}
+
+	for _, tx := range *issuedTxs {
+		txID, err := ids.FromString(tx.TransactionId)
+		if err != nil {
+			log.Printf("invalid transaction id %s of multisig tx %s: %v", tx.TransactionId, tx.Id, err)
+			continue
+		}
+		resp, err := t.nodeService.GetTxStatus(txID)
+		if err != nil {
+			log.Printf("failed to get status of tx %s: %v", tx.TransactionId, err)
+			continue
+		}
+		// the node might not know the tx yet right after issuance
+		if resp.Status == status.Unknown {
+			continue
+		}
+
+		txStatus := resp.Status.String()
+		if txStatus != tx.TxStatus {
+			_, err = t.dao.UpdateTxStatus(tx.Id, txStatus, resp.Reason)
+			if err != nil {
+				return err
+			}
+		}
+
+		var state model.MultisigTxState
+		switch resp.Status {
+		case status.Committed:
+			state = model.MultisigTxStateCommitted
+		case status.Aborted, status.Dropped:
+			state = model.MultisigTxStateRejected
+		default:
+			continue
+		}
+		err = validateStateTransition(tx.State, state)
+		if err != nil {
+			log.Printf("multisig tx %s cannot be moved from %s to %s: %v", tx.Id, tx.State, state, err)
+			continue
+		}
+		updated, err := t.dao.UpdateState(tx.Id, tx.State, state)
+		if err != nil {
+			return err
+		}
+		if updated && state == model.MultisigTxStateCommitted {
+			t.publisher.Publish(model.MultisigTxEvent{
+				Type:      model.MultisigTxEventCommitted,
+				TxId:      tx.Id,
+				Alias:     tx.Alias,
+				State:     state,
+				Timestamp: time.Now().UTC(),
+			})
+		}
+		// children of a rejected tx cannot be committed anymore
+		if updated && state == model.MultisigTxStateRejected {
+			tx.State = state
+			invalidateChildTxs(t.dao, &tx)
+		}
+	}
+	return nil
+}
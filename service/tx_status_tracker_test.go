@@ -0,0 +1,121 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackIssuedTxs(t *testing.T) {
+	txId := ids.GenerateTestID()
+
+	tests := []struct {
+		name       string
+		storedTx   model.MultisigTx
+		mockFn     func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService)
+		wantEvents int
+		wantErr    bool
+	}{
+		{
+			name:     "Committed tx",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Committed}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus("1", status.Committed.String(), "").Return(true, nil).Times(1)
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStateIssued, model.MultisigTxStateCommitted).Return(true, nil).Times(1)
+			},
+			wantEvents: 1,
+		},
+		{
+			name:     "Dropped tx with reason",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued, TxStatus: status.Processing.String()},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Dropped, Reason: "failed verification"}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus("1", status.Dropped.String(), "failed verification").Return(true, nil).Times(1)
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStateIssued, model.MultisigTxStateRejected).Return(true, nil).Times(1)
+				mockDao.EXPECT().GetChildTxIds([]string{"1", txId.String()}).Return([]string{}, nil).Times(1)
+			},
+		},
+		{
+			name:     "Committed status already stored",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued, TxStatus: status.Committed.String()},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Committed}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				mockDao.EXPECT().UpdateState("1", model.MultisigTxStateIssued, model.MultisigTxStateCommitted).Return(true, nil).Times(1)
+			},
+			wantEvents: 1,
+		},
+		{
+			name:     "Unchanged status",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued, TxStatus: status.Processing.String()},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Processing}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Unknown status",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Unknown}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Node error",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(nil, errors.New("node error")).Times(1)
+				mockDao.EXPECT().UpdateTxStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:     "Dao error",
+			storedTx: model.MultisigTx{Id: "1", Alias: "alias", TransactionId: txId.String(), State: model.MultisigTxStateIssued},
+			mockFn: func(mockDao *dao.MockMultisigTxDao, mockNodeService *MockNodeService) {
+				mockNodeService.EXPECT().GetTxStatus(txId).Return(&platformvm.GetTxStatusResponse{Status: status.Aborted}, nil).Times(1)
+				mockDao.EXPECT().UpdateTxStatus("1", status.Aborted.String(), "").Return(false, errors.New("dao error")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNodeService := NewMockNodeService(ctrl)
+			mockDao := dao.NewMockMultisigTxDao(ctrl)
+
+			mockDao.EXPECT().GetUnsettledIssuedTx().Return(&[]model.MultisigTx{tt.storedTx}, nil).Times(1)
+			tt.mockFn(mockDao, mockNodeService)
+
+			publisher := &recordingEventPublisher{}
+			tracker := &txStatusTracker{
+				config:      &util.Config{},
+				dao:         mockDao,
+				nodeService: mockNodeService,
+				publisher:   publisher,
+			}
+			err := tracker.trackIssuedTxs()
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Len(t, publisher.events, tt.wantEvents)
+			for _, event := range publisher.events {
+				require.Equal(t, model.MultisigTxEventCommitted, event.Type)
+				require.Equal(t, tt.storedTx.Alias, event.Alias)
+			}
+		})
+	}
+}
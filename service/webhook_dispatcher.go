@@ -0,0 +1,169 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+)
+
+const (
+	defaultWebhookDispatchInterval = 5 * time.Second
+	defaultWebhookMaxAttempts      = 8
+	webhookDispatchBatchSize       = 100
+	webhookRequestTimeout          = 10 * time.Second
+	webhookInitialBackoff          = 30 * time.Second
+	webhookMaxBackoff              = 6 * time.Hour
+)
+
+// headers of a webhook delivery
+const (
+	WebhookEventHeader     = "X-Signavault-Event"
+	WebhookDeliveryHeader  = "X-Signavault-Delivery"
+	WebhookTimestampHeader = "X-Signavault-Timestamp"
+	WebhookSignatureHeader = "X-Signavault-Signature"
+)
+
+// WebhookDispatcher periodically sends the queued webhook deliveries. A failed delivery is retried with
+// exponential backoff and moved to the dead letters once the maximum number of attempts has been reached.
+type WebhookDispatcher interface {
+	Start(ctx context.Context)
+}
+
+type webhookDispatcher struct {
+	config *util.Config
+	dao    dao.WebhookDao
+	client *http.Client
+}
+
+func NewWebhookDispatcher(config *util.Config, dao dao.WebhookDao) WebhookDispatcher {
+	return &webhookDispatcher{
+		config: config,
+		dao:    dao,
+		client: &http.Client{Timeout: webhookRequestTimeout},
+	}
+}
+
+func (d *webhookDispatcher) Start(ctx context.Context) {
+	interval := defaultWebhookDispatchInterval
+	if d.config.WebhookDispatchInterval > 0 {
+		interval = time.Duration(d.config.WebhookDispatchInterval) * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := d.dispatchDueDeliveries(time.Now().UTC()); err != nil {
+					log.Printf("failed to dispatch webhook deliveries: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (d *webhookDispatcher) dispatchDueDeliveries(now time.Time) error {
+	deliveries, err := d.dao.GetDueDeliveries(now, webhookDispatchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range *deliveries {
+		delivery := &(*deliveries)[i]
+		deliveryErr := d.deliver(delivery, now)
+		if deliveryErr == nil {
+			err = d.dao.DeleteDelivery(delivery.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		delivery.Attempts++
+		if delivery.Attempts >= d.maxAttempts() {
+			log.Printf("webhook delivery %d to %s failed %d times, moving it to the dead letters: %v", delivery.Id, delivery.Url, delivery.Attempts, deliveryErr)
+			err = d.dao.MoveToDeadLetters(delivery, deliveryErr.Error())
+		} else {
+			err = d.dao.RescheduleDelivery(delivery.Id, delivery.Attempts, now.Add(webhookBackoff(delivery.Attempts)), deliveryErr.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *webhookDispatcher) maxAttempts() int {
+	if d.config.WebhookMaxAttempts > 0 {
+		return d.config.WebhookMaxAttempts
+	}
+	return defaultWebhookMaxAttempts
+}
+
+// deliver posts the payload to the url of the subscription. Any response other than 2xx is a failed attempt.
+func (d *webhookDispatcher) deliver(delivery *model.WebhookDelivery, now time.Time) error {
+	req, err := http.NewRequest(http.MethodPost, delivery.Url, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.Id, 10))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Print(err)
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of the timestamp and the payload, separated by a dot,
+// keyed with the secret of the subscription. Receivers should reject deliveries with an old timestamp.
+func SignWebhookPayload(secret string, timestamp string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the next attempt of a delivery which has failed the given number of
+// times, doubling with every attempt
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookInitialBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/chain4travel/camino-signavault/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchDueDeliveries(t *testing.T) {
+	now := time.Now().UTC()
+	secret := "secret"
+	payload := `{"type":"signed","txId":"1"}`
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		mockFn     func(mockDao *dao.MockWebhookDao, delivery *model.WebhookDelivery)
+	}{
+		{
+			name:       "Delivered",
+			statusCode: http.StatusOK,
+			mockFn: func(mockDao *dao.MockWebhookDao, delivery *model.WebhookDelivery) {
+				mockDao.EXPECT().DeleteDelivery(delivery.Id).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Failed delivery is retried",
+			statusCode: http.StatusInternalServerError,
+			attempts:   2,
+			mockFn: func(mockDao *dao.MockWebhookDao, delivery *model.WebhookDelivery) {
+				mockDao.EXPECT().RescheduleDelivery(delivery.Id, 3, now.Add(120*time.Second), "webhook responded with status 500").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Failed delivery is moved to the dead letters",
+			statusCode: http.StatusNotFound,
+			attempts:   defaultWebhookMaxAttempts - 1,
+			mockFn: func(mockDao *dao.MockWebhookDao, delivery *model.WebhookDelivery) {
+				mockDao.EXPECT().MoveToDeadLetters(gomock.Any(), "webhook responded with status 404").Do(func(d *model.WebhookDelivery, _ string) {
+					require.Equal(t, delivery.Id, d.Id)
+					require.Equal(t, defaultWebhookMaxAttempts, d.Attempts)
+				}).Return(nil).Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				timestamp := r.Header.Get(WebhookTimestampHeader)
+				assert.Equal(t, strconv.FormatInt(now.Unix(), 10), timestamp)
+				assert.Equal(t, "sha256="+SignWebhookPayload(secret, timestamp, string(body)), r.Header.Get(WebhookSignatureHeader))
+				assert.Equal(t, string(model.MultisigTxEventSigned), r.Header.Get(WebhookEventHeader))
+				assert.Equal(t, "1", r.Header.Get(WebhookDeliveryHeader))
+				assert.Equal(t, payload, string(body))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockWebhookDao(ctrl)
+			delivery := model.WebhookDelivery{
+				Id:             1,
+				SubscriptionId: 1,
+				Url:            server.URL,
+				Secret:         secret,
+				EventType:      model.MultisigTxEventSigned,
+				Payload:        payload,
+				Attempts:       tt.attempts,
+				NextAttemptAt:  &now,
+			}
+			mockDao.EXPECT().GetDueDeliveries(now, webhookDispatchBatchSize).Return(&[]model.WebhookDelivery{delivery}, nil).Times(1)
+			tt.mockFn(mockDao, &delivery)
+
+			dispatcher := &webhookDispatcher{
+				config: &util.Config{},
+				dao:    mockDao,
+				client: server.Client(),
+			}
+			err := dispatcher.dispatchDueDeliveries(now)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, webhookBackoff(1))
+	require.Equal(t, 60*time.Second, webhookBackoff(2))
+	require.Equal(t, 8*time.Minute, webhookBackoff(5))
+	require.Equal(t, webhookMaxBackoff, webhookBackoff(100))
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+)
+
+var (
+	ErrInvalidWebhookUrl            = errors.New("webhook url must be an absolute http or https url")
+	ErrInvalidWebhookEvent          = errors.New("unknown webhook event")
+	ErrWebhookSubscriptionNotExists = errors.New("webhook subscription does not exist")
+	ErrDeadLetterNotExists          = errors.New("webhook dead letter does not exist")
+)
+
+const webhookSecretSize = 32
+
+var _ WebhookService = (*webhookService)(nil)
+
+type WebhookService interface {
+	CreateSubscription(args *dto.WebhookSubscriptionArgs) (*model.WebhookSubscription, error)
+	GetSubscriptions() (*[]model.WebhookSubscription, error)
+	DeleteSubscription(id int64) error
+	GetDeadLetters(limit int, offset int) (*dto.WebhookDeadLettersResponse, error)
+	RedeliverDeadLetter(id int64) error
+}
+
+type webhookService struct {
+	dao dao.WebhookDao
+}
+
+func NewWebhookService(dao dao.WebhookDao) WebhookService {
+	return &webhookService{
+		dao: dao,
+	}
+}
+
+// CreateSubscription stores the subscription with a newly generated secret. The secret is only returned here,
+// receivers need it to verify the signature of the deliveries.
+func (s *webhookService) CreateSubscription(args *dto.WebhookSubscriptionArgs) (*model.WebhookSubscription, error) {
+	u, err := url.Parse(args.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+	for _, event := range args.Events {
+		if !isMultisigTxEventType(event) {
+			return nil, ErrInvalidWebhookEvent
+		}
+	}
+
+	secret := make([]byte, webhookSecretSize)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+	events := args.Events
+	if events == nil {
+		events = make([]model.MultisigTxEventType, 0)
+	}
+	now := time.Now().UTC()
+	subscription := &model.WebhookSubscription{
+		Alias:     args.Alias,
+		Url:       args.Url,
+		Secret:    hex.EncodeToString(secret),
+		Events:    events,
+		CreatedAt: &now,
+	}
+	subscription.Id, err = s.dao.CreateSubscription(subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func isMultisigTxEventType(eventType model.MultisigTxEventType) bool {
+	for _, t := range model.MultisigTxEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *webhookService) GetSubscriptions() (*[]model.WebhookSubscription, error) {
+	return s.dao.GetSubscriptions()
+}
+
+func (s *webhookService) DeleteSubscription(id int64) error {
+	deleted, err := s.dao.DeleteSubscription(id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWebhookSubscriptionNotExists
+	}
+	return nil
+}
+
+func (s *webhookService) GetDeadLetters(limit int, offset int) (*dto.WebhookDeadLettersResponse, error) {
+	limit, offset = pageBounds(limit, offset)
+	deadLetters, total, err := s.dao.GetDeadLetters(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.WebhookDeadLettersResponse{
+		DeadLetters: *deadLetters,
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+	}, nil
+}
+
+// RedeliverDeadLetter queues the dead letter for delivery again, starting over with the retries
+func (s *webhookService) RedeliverDeadLetter(id int64) error {
+	redelivered, err := s.dao.RedeliverDeadLetter(id)
+	if err != nil {
+		return err
+	}
+	if !redelivered {
+		return ErrDeadLetterNotExists
+	}
+	return nil
+}
+
+type webhookOutboxSink struct {
+	dao dao.WebhookDao
+}
+
+// NewWebhookOutboxSink returns a sink which queues a delivery of the event for every matching webhook
+// subscription. The deliveries are sent by the WebhookDispatcher.
+func NewWebhookOutboxSink(dao dao.WebhookDao) OutboxSink {
+	return &webhookOutboxSink{
+		dao: dao,
+	}
+}
+
+func (s *webhookOutboxSink) Name() string {
+	return OutboxSinkWebhook
+}
+
+func (s *webhookOutboxSink) Deliver(event model.MultisigTxEvent) error {
+	subscriptions, err := s.dao.GetSubscriptionsForAlias(event.Alias)
+	if err != nil {
+		return fmt.Errorf("couldn't get webhook subscriptions for alias %s: %w", event.Alias, err)
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]model.WebhookDelivery, 0)
+	for _, subscription := range *subscriptions {
+		if !subscription.Matches(&event) {
+			continue
+		}
+		deliveries = append(deliveries, model.WebhookDelivery{
+			SubscriptionId: subscription.Id,
+			EventType:      event.Type,
+			Payload:        string(payload),
+			NextAttemptAt:  &now,
+		})
+	}
+	return s.dao.EnqueueDeliveries(deliveries)
+}
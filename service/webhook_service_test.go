@@ -0,0 +1,148 @@
+/*
+ * Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+ * See the file LICENSE for licensing terms.
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/chain4travel/camino-signavault/dao"
+	"github.com/chain4travel/camino-signavault/dto"
+	"github.com/chain4travel/camino-signavault/model"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   *dto.WebhookSubscriptionArgs
+		mockFn func(mockDao *dao.MockWebhookDao)
+		err    error
+	}{
+		{
+			name: "Create subscription",
+			args: &dto.WebhookSubscriptionArgs{
+				Alias:  "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy",
+				Url:    "https://example.com/webhook",
+				Events: []model.MultisigTxEventType{model.MultisigTxEventSigned, model.MultisigTxEventThresholdReached},
+			},
+			mockFn: func(mockDao *dao.MockWebhookDao) {
+				mockDao.EXPECT().CreateSubscription(gomock.Any()).Return(int64(1), nil).Times(1)
+			},
+		},
+		{
+			name: "Create subscription for all events of all aliases",
+			args: &dto.WebhookSubscriptionArgs{
+				Url: "http://localhost:8081",
+			},
+			mockFn: func(mockDao *dao.MockWebhookDao) {
+				mockDao.EXPECT().CreateSubscription(gomock.Any()).Return(int64(1), nil).Times(1)
+			},
+		},
+		{
+			name: "Create subscription - invalid url",
+			args: &dto.WebhookSubscriptionArgs{
+				Url: "ftp://example.com/webhook",
+			},
+			mockFn: func(mockDao *dao.MockWebhookDao) {
+				mockDao.EXPECT().CreateSubscription(gomock.Any()).Times(0)
+			},
+			err: ErrInvalidWebhookUrl,
+		},
+		{
+			name: "Create subscription - unknown event",
+			args: &dto.WebhookSubscriptionArgs{
+				Url:    "https://example.com/webhook",
+				Events: []model.MultisigTxEventType{"unknown"},
+			},
+			mockFn: func(mockDao *dao.MockWebhookDao) {
+				mockDao.EXPECT().CreateSubscription(gomock.Any()).Times(0)
+			},
+			err: ErrInvalidWebhookEvent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDao := dao.NewMockWebhookDao(ctrl)
+			tt.mockFn(mockDao)
+
+			got, err := NewWebhookService(mockDao).CreateSubscription(tt.args)
+			require.Equal(t, tt.err, err)
+			if tt.err != nil {
+				return
+			}
+			require.Equal(t, int64(1), got.Id)
+			require.Equal(t, tt.args.Alias, got.Alias)
+			require.Equal(t, tt.args.Url, got.Url)
+			require.Len(t, got.Secret, 2*webhookSecretSize)
+			require.NotNil(t, got.Events)
+			require.Len(t, got.Events, len(tt.args.Events))
+		})
+	}
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDao := dao.NewMockWebhookDao(ctrl)
+
+	mockDao.EXPECT().DeleteSubscription(int64(1)).Return(true, nil).Times(1)
+	mockDao.EXPECT().DeleteSubscription(int64(2)).Return(false, nil).Times(1)
+
+	s := NewWebhookService(mockDao)
+	require.NoError(t, s.DeleteSubscription(1))
+	require.Equal(t, ErrWebhookSubscriptionNotExists, s.DeleteSubscription(2))
+}
+
+func TestRedeliverDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDao := dao.NewMockWebhookDao(ctrl)
+
+	mockDao.EXPECT().RedeliverDeadLetter(int64(1)).Return(true, nil).Times(1)
+	mockDao.EXPECT().RedeliverDeadLetter(int64(2)).Return(false, nil).Times(1)
+
+	s := NewWebhookService(mockDao)
+	require.NoError(t, s.RedeliverDeadLetter(1))
+	require.Equal(t, ErrDeadLetterNotExists, s.RedeliverDeadLetter(2))
+}
+
+func TestWebhookOutboxSink(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDao := dao.NewMockWebhookDao(ctrl)
+
+	alias := "P-kopernikus1k4przmfu79ypp4u7y98glmdpzwk0u3sc7saazy"
+	event := model.MultisigTxEvent{
+		Type:           model.MultisigTxEventSigned,
+		TxId:           "1",
+		Alias:          alias,
+		State:          model.MultisigTxStatePending,
+		Actor:          "P-kopernikus1g65uqn6t77p656w64023nh8nd9updzmxh8ttv3",
+		IdempotencyKey: "0123456789abcdef0123456789abcdef",
+		Timestamp:      time.Now().UTC(),
+	}
+	payload, _ := json.Marshal(event)
+
+	mockDao.EXPECT().GetSubscriptionsForAlias(alias).Return(&[]model.WebhookSubscription{
+		{Id: 1, Events: []model.MultisigTxEventType{}},
+		{Id: 2, Alias: alias, Events: []model.MultisigTxEventType{model.MultisigTxEventSigned}},
+		{Id: 3, Events: []model.MultisigTxEventType{model.MultisigTxEventCancelled}},
+	}, nil).Times(1)
+	mockDao.EXPECT().EnqueueDeliveries(gomock.Any()).Do(func(deliveries []model.WebhookDelivery) {
+		require.Len(t, deliveries, 2)
+		require.Equal(t, int64(1), deliveries[0].SubscriptionId)
+		require.Equal(t, int64(2), deliveries[1].SubscriptionId)
+		for _, delivery := range deliveries {
+			require.Equal(t, model.MultisigTxEventSigned, delivery.EventType)
+			require.Equal(t, string(payload), delivery.Payload)
+			require.NotNil(t, delivery.NextAttemptAt)
+		}
+	}).Return(nil).Times(1)
+
+	err := NewWebhookOutboxSink(mockDao).Deliver(event)
+	require.NoError(t, err)
+}
@@ -3,8 +3,8 @@ VALUES ('1', 'unsigned_tx', 'alias', 2, '11111111111111111111111111111111LpoYY',
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
 VALUES ('1', 'address', 'signature', true, NOW());
 
-INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, expires_at, created_at)
-VALUES ('2', 'unsigned_tx_2', 'alias_2', 3, '11111111111111111111111111111111LpoYY', 'metadata_2', 'output_owners_2', 'transaction_id_2', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, state, expires_at, created_at)
+VALUES ('2', 'unsigned_tx_2', 'alias_2', 3, '11111111111111111111111111111111LpoYY', 'metadata_2', 'output_owners_2', 'transaction_id_2', 'issued', NOW() + INTERVAL 1 YEAR, NOW());
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
 VALUES ('2', 'address1', 'signature1', true, NOW());
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
@@ -19,8 +19,8 @@ VALUES ('3', 'address1', 'signature1', true, NOW());
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
 VALUES ('3', 'address2', 'signature2', true, NOW());
 
-INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, expires_at, created_at)
-VALUES ('4', 'unsigned_tx_4', 'alias_3', 2, '11111111111111111111111111111111LpoYY', 'metadata_3','output_owners_3', 'transaction_id_3', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, state, expires_at, created_at)
+VALUES ('4', 'unsigned_tx_4', 'alias_3', 2, '11111111111111111111111111111111LpoYY', 'metadata_3','output_owners_3', 'transaction_id_3', 'issued', NOW() + INTERVAL 1 YEAR, NOW());
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
 VALUES ('4', 'address1', 'signature1', true, NOW());
 
@@ -29,7 +29,79 @@ VALUES ('5', 'unsigned_tx_5', 'alias_3', 2, 'jvYyfQTxGMJLuGWa55kdP2p2zSUYsQ5Raup
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
 VALUES ('5', 'address1', 'signature1', true, NOW());
 
-INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, expires_at, created_at)
-VALUES ('6', 'unsigned_tx_6', 'alias_6', 2, 'jvYyfQTxGMJLuGWa55kdP2p2zSUYsQ5Raupu4TW34ZAUBAbtq', 'metadata_3','output_owners_3', 'transaction_id_6', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, state, expires_at, created_at)
+VALUES ('6', 'unsigned_tx_6', 'alias_6', 2, 'jvYyfQTxGMJLuGWa55kdP2p2zSUYsQ5Raupu4TW34ZAUBAbtq', 'metadata_3','output_owners_3', 'transaction_id_6', 'issued', NOW() + INTERVAL 1 YEAR, NOW());
 INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
-VALUES ('6', 'address1', 'signature1', true, NOW());
\ No newline at end of file
+VALUES ('6', 'address1', 'signature1', true, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, state, expires_at, created_at)
+VALUES ('7', 'unsigned_tx_7', 'alias_7', 2, '11111111111111111111111111111111LpoYY', 'metadata_7', 'output_owners_7', 'cancelled', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('7', 'address1', 'signature1', true, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, expires_at, created_at)
+VALUES ('8', 'unsigned_tx_8', 'alias_8', 2, '11111111111111111111111111111111LpoYY', 'metadata_8', 'output_owners_8', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('8', 'address1', 'signature1', true, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, state, expires_at, created_at)
+VALUES ('9', 'unsigned_tx_9', 'alias_9', 1, '11111111111111111111111111111111LpoYY', 'metadata_9', 'output_owners_9', 'threshold_reached', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('9', 'address1', 'signature1', true, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('9', 'address2', NULL, false, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, creator, expires_at, created_at)
+VALUES ('10', 'unsigned_tx_10', 'alias_10', 2, '11111111111111111111111111111111LpoYY', 'metadata_10', 'output_owners_10', 'address1', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('10', 'address1', NULL, false, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('10', 'address2', NULL, false, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, creator, expires_at, created_at)
+VALUES ('11', 'unsigned_tx_11', 'alias_11', 1, '11111111111111111111111111111111LpoYY', 'metadata_11', 'output_owners_11', 'address1', NOW() + INTERVAL 1 DAY, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('11', 'address1', NULL, false, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, expires_at, created_at)
+VALUES ('12', 'unsigned_tx_12', 'alias_12', 1, '11111111111111111111111111111111LpoYY', 'metadata_12', 'output_owners_12', NOW() - INTERVAL 1 DAY, NOW() - INTERVAL 1 MONTH);
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('12', 'address1', NULL, false, NOW() - INTERVAL 1 MONTH);
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, parent_transaction, expires_at, created_at)
+VALUES ('14', 'unsigned_tx_14', 'alias_14', 1, '11111111111111111111111111111111LpoYY', 'metadata_14', 'output_owners_14', 'transaction_id_2', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('14', 'address1', NULL, false, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, expires_at, created_at)
+VALUES ('15', 'unsigned_tx_15', 'alias_15', 2, '11111111111111111111111111111111LpoYY', 'metadata_15', 'output_owners_15', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('15', 'inbox_address', NULL, false, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('15', 'address1', 'signature1', true, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, expires_at, created_at)
+VALUES ('16', 'unsigned_tx_16', 'alias_16', 2, '11111111111111111111111111111111LpoYY', 'metadata_16', 'output_owners_16', NOW() + INTERVAL 1 YEAR, NOW());
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('16', 'inbox_address', 'signature1', true, NOW());
+
+INSERT INTO multisig_tx (id, unsigned_tx, alias, threshold, chain_id, metadata, output_owners, transaction_id, state, expires_at, created_at)
+VALUES ('17', 'unsigned_tx_17', 'alias_17', 1, '11111111111111111111111111111111LpoYY', 'metadata_17', 'output_owners_17', 'transaction_id_17', 'committed', NOW() - INTERVAL 1 DAY, NOW() - INTERVAL 1 MONTH);
+INSERT INTO multisig_tx_owners (multisig_tx_id, address, signature, is_signer, created_at)
+VALUES ('17', 'address17', 'signature17', true, NOW() - INTERVAL 1 MONTH);
+
+INSERT INTO multisig_tx_archive (id, unsigned_tx, alias, threshold, chain_id, output_owners, metadata, state, expires_at, created_at, archived_at)
+VALUES ('13', 'unsigned_tx_13', 'alias_13', 1, '11111111111111111111111111111111LpoYY', 'output_owners_13', 'metadata_13', 'expired', NOW() - INTERVAL 1 YEAR, NOW() - INTERVAL 1 YEAR, NOW() - INTERVAL 1 YEAR);
+INSERT INTO multisig_tx_owners_archive (archive_id, address, signature, is_signer, created_at)
+VALUES (LAST_INSERT_ID(), 'address1', NULL, false, NOW() - INTERVAL 1 YEAR);
+
+INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id)
+VALUES ('1', 'utxo_1');
+INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id)
+VALUES ('1', 'utxo_shared');
+INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id)
+VALUES ('2', 'utxo_2');
+INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id)
+VALUES ('2', 'utxo_shared');
+INSERT INTO multisig_tx_inputs (multisig_tx_id, utxo_id)
+VALUES ('7', 'utxo_7');
@@ -12,11 +12,25 @@ import (
 )
 
 type Config struct {
-	ListenerAddress string   `mapstructure:"listenerAddress"`
-	Database        Database `mapstructure:"database"`
-	CaminoNode      string   `mapstructure:"caminoNode"`
-	NetworkId       uint32   `mapstructure:"networkId"`
-	TxExpiration    int      `mapstructure:"txExpirationDays"`
+	ListenerAddress         string   `mapstructure:"listenerAddress"`
+	Database                Database `mapstructure:"database"`
+	CaminoNode              string   `mapstructure:"caminoNode"`
+	NetworkId               uint32   `mapstructure:"networkId"`
+	TxExpiration            int      `mapstructure:"txExpirationDays"`
+	TxMaxExpiration         int      `mapstructure:"txMaxExpirationDays"`
+	TxStatusPollInterval    int      `mapstructure:"txStatusPollIntervalSeconds"`
+	StaleInputCheckInterval int      `mapstructure:"staleInputCheckIntervalSeconds"`
+	AliasCheckInterval      int      `mapstructure:"aliasCheckIntervalSeconds"`
+	ExpirySweepInterval     int      `mapstructure:"expirySweepIntervalSeconds"`
+	ArchiveRetention        int      `mapstructure:"archiveRetentionDays"`
+	CancelPolicy            string   `mapstructure:"cancelPolicy"`
+	CancelQuorum            int      `mapstructure:"cancelQuorum"`
+	WebhookApiKey           string   `mapstructure:"webhookApiKey"`
+	WebhookDispatchInterval int      `mapstructure:"webhookDispatchIntervalSeconds"`
+	WebhookMaxAttempts      int      `mapstructure:"webhookMaxAttempts"`
+	OutboxRelayInterval     int      `mapstructure:"outboxRelayIntervalSeconds"`
+	OutboxMaxAttempts       int      `mapstructure:"outboxMaxAttempts"`
+	OutboxSinks             []string `mapstructure:"outboxSinks"`
 }
 
 type Database struct {
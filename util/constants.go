@@ -1,3 +1,10 @@
 package util
 
 const PChainAlias = "P"
+
+// policies deciding who can cancel a pending multisig tx
+const (
+	CancelPolicyCreator = "creator" // only the creator of the tx
+	CancelPolicyOwner   = "owner"   // any owner of the tx
+	CancelPolicyQuorum  = "quorum"  // the cancel votes of a quorum of owners
+)